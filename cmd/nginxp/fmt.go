@@ -0,0 +1,229 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/piger/nginxp/nginx"
+)
+
+const fmtUsage = `Usage of fmt: [flags] [path ...]
+
+Fmt formats NGINX configuration files. Without an explicit path, it formats the
+standard input; a directory is formatted recursively, considering only the files
+with a .conf extension. By default, the formatted files are printed to the
+standard output.
+`
+
+// fmtFlags are the flags of the fmt command.
+type fmtFlags struct {
+	list  bool
+	write bool
+	diff  bool
+	opts  nginx.Options
+}
+
+func runFmt(args []string) error {
+	flags := flag.NewFlagSet("fmt", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), fmtUsage)
+		flags.PrintDefaults()
+	}
+	ff, err := parseFmtFlags(flags, args)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		if ff.write {
+			return errors.New("can't use -w with the standard input")
+		}
+		return ff.process("<standard input>", os.Stdin, os.Stdout)
+	}
+
+	var failed bool
+	for _, path := range flags.Args() {
+		err := filepath.WalkDir(path, func(name string, d os.DirEntry, err error) error {
+			if err == nil && d.IsDir() {
+				return nil
+			}
+			// the files named on the command line are formatted regardless of their extension.
+			if err == nil && name != path && !strings.HasSuffix(name, ".conf") {
+				return nil
+			}
+			if err == nil {
+				err = ff.processFile(name)
+			}
+			if err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				failed = true
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if failed {
+		return errors.New("some files could not be formatted")
+	}
+	return nil
+}
+
+// parseFmtFlags defines the flags of the fmt command in flags and parses args.
+func parseFmtFlags(flags *flag.FlagSet, args []string) (*fmtFlags, error) {
+	var ff fmtFlags
+	def := nginx.DefaultOptions
+	var tabs, nextLine bool
+	flags.BoolVar(&ff.list, "l", false, "List the files whose formatting differs from nginxp's")
+	flags.BoolVar(&ff.write, "w", false, "Write the result to the source file instead of the standard output")
+	flags.BoolVar(&ff.diff, "d", false, "Display diffs instead of rewriting files")
+	flags.IntVar(&ff.opts.IndentWidth, "indent", def.IndentWidth, "Number of indentation characters for each level")
+	flags.BoolVar(&tabs, "tabs", false, "Indent with tabs instead of spaces; each level is one tab, unless -indent is given")
+	flags.BoolVar(&ff.opts.AlignArguments, "align", def.AlignArguments, "Align the arguments of consecutive directives")
+	flags.IntVar(&ff.opts.MaxEmptyLines, "max-empty-lines", def.MaxEmptyLines, "Maximum number of consecutive empty lines")
+	flags.BoolVar(&nextLine, "brace-next-line", false, "Write the opening brace of a block on its own line")
+	flags.BoolVar(&ff.opts.NormalizeQuotes, "normalize-quotes", def.NormalizeQuotes, "Write single quoted arguments with double quotes")
+	flags.BoolVar(&ff.opts.TrimTrailingSpace, "trim-space", def.TrimTrailingSpace, "Remove trailing whitespace from comments and Lua code")
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	ff.opts.IndentChar = def.IndentChar
+	if tabs {
+		ff.opts.IndentChar = '\t'
+		indent := false
+		flags.Visit(func(f *flag.Flag) {
+			indent = indent || f.Name == "indent"
+		})
+		if !indent {
+			ff.opts.IndentWidth = 1
+		}
+	}
+	if nextLine {
+		ff.opts.BraceStyle = nginx.BraceNextLine
+	}
+	return &ff, nil
+}
+
+// processFile formats the named file.
+func (ff *fmtFlags) processFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return ff.process(name, f, os.Stdout)
+}
+
+// process formats the configuration read from in and writes the result, or the diff or the
+// name of the file, to out, according to the flags.
+func (ff *fmtFlags) process(name string, in io.Reader, out io.Writer) error {
+	src, err := io.ReadAll(in)
+	if err != nil {
+		return err
+	}
+
+	tree, err := parse(name, string(src))
+	if err != nil {
+		return err
+	}
+	res := nginx.Format(tree, ff.opts)
+
+	if !ff.list && !ff.write && !ff.diff {
+		_, err = out.Write(res)
+		return err
+	}
+	if bytes.Equal(src, res) {
+		return nil
+	}
+
+	if ff.list {
+		fmt.Fprintln(out, name)
+	}
+	if ff.write {
+		info, err := os.Stat(name)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(name, res, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	if ff.diff {
+		data, err := diff(name, src, res)
+		if err != nil {
+			return fmt.Errorf("computing diff: %s", err)
+		}
+		if _, err := out.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parse parses a configuration that must be formatted.
+func parse(name, src string) (*nginx.Tree, error) {
+	t := nginx.New(name)
+	t.Mode = nginx.SkipContextCheck | nginx.AllErrors
+	return lenient(t.Parse(src))
+}
+
+// lenient returns the tree parsed with the SkipContextCheck and AllErrors modes, unless err
+// contains lexer or syntax errors: unlike nginx, the commands accept unknown directives and
+// directives in the wrong context, so that they work with included files and configurations
+// using third party modules, but they refuse invalid syntax.
+func lenient(tree *nginx.Tree, err error) (*nginx.Tree, error) {
+	var errs nginx.ErrorList
+	if errors.As(err, &errs) {
+		for _, e := range errs {
+			if e.Severity == nginx.SeverityError && (e.Kind == nginx.KindLex || e.Kind == nginx.KindSyntax) {
+				return nil, e
+			}
+		}
+		return tree, nil
+	}
+	return tree, err
+}
+
+// diff returns the unified diff between the original and the formatted configuration, using
+// the diff program.
+func diff(name string, a, b []byte) ([]byte, error) {
+	fa, err := writeTemp(a)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(fa)
+	fb, err := writeTemp(b)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(fb)
+
+	data, err := exec.Command("diff", "-u", "--label", name+".orig", "--label", name, fa, fb).CombinedOutput()
+	if len(data) > 0 {
+		// diff exits with a non-zero status when the files differ.
+		return data, nil
+	}
+	return nil, err
+}
+
+// writeTemp writes data to a temporary file and returns its name.
+func writeTemp(data []byte) (string, error) {
+	f, err := os.CreateTemp("", "nginxp")
+	if err != nil {
+		return "", err
+	}
+	_, err = f.Write(data)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return f.Name(), err
+}
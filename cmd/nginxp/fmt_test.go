@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestFmtIndentFlags(t *testing.T) {
+	var tests = []struct {
+		args []string
+		want string
+	}{
+		{nil, "http {\n    index index.html;\n}\n"},
+		{[]string{"-indent", "2"}, "http {\n  index index.html;\n}\n"},
+		{[]string{"-tabs"}, "http {\n\tindex index.html;\n}\n"},
+		{[]string{"-tabs", "-indent", "2"}, "http {\n\t\tindex index.html;\n}\n"},
+	}
+	for _, test := range tests {
+		flags := flag.NewFlagSet("fmt", flag.ContinueOnError)
+		flags.SetOutput(io.Discard)
+		ff, err := parseFmtFlags(flags, test.args)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", test.args, err)
+			continue
+		}
+		var out bytes.Buffer
+		if err := ff.process("test", strings.NewReader("http {\nindex index.html;\n}\n"), &out); err != nil {
+			t.Errorf("%q: unexpected error: %s", test.args, err)
+			continue
+		}
+		if out.String() != test.want {
+			t.Errorf("%q: got %q, want %q", test.args, out.String(), test.want)
+		}
+	}
+}
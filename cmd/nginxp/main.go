@@ -0,0 +1,54 @@
+// Command nginxp is a collection of tools for NGINX configuration files.
+//
+// Usage:
+//
+//	nginxp <command> [flags] [arguments]
+//
+// The commands are:
+//
+//	fmt	format configuration files
+//	route	show the server and the location handling a request
+//	vhosts	show the servers listening on each address and port
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// commands maps the name of each command to the function running it; the function receives
+// the arguments following the name of the command.
+var commands = map[string]func(args []string) error{
+	"fmt":    runFmt,
+	"route":  runRoute,
+	"vhosts": runVhosts,
+}
+
+var usage = func() {
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage of %s: <command> [flags] [arguments]\n\nThe commands are:\n", os.Args[0])
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(flag.CommandLine.Output(), "\t%s\n", name)
+	}
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	run, ok := commands[flag.Arg(0)]
+	if !ok {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err := run(flag.Args()[1:]); err != nil {
+		fmt.Fprintf(os.Stderr, "nginxp %s: %s\n", flag.Arg(0), err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/piger/nginxp/nginx"
+)
+
+const routeUsage = `Usage of route: [-listen address:port] [-effective] <config> <host> <uri>
+
+Route shows the server and the location that handle a request for host and uri,
+and the locations considered by nginx to choose it. The configuration can be a
+configuration file, whose included files are read from disk, or the output of
+"nginx -T"; use "-" to read it from the standard input.
+
+Without -listen, the server is chosen only by name, ignoring the listen directives.
+With -effective, it also shows the directives that apply to the request after the
+inheritance from the outer blocks, with the file and the line of each one.
+`
+
+func runRoute(args []string) error {
+	flags := flag.NewFlagSet("route", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), routeUsage)
+		flags.PrintDefaults()
+	}
+	listen := flags.String("listen", "", "the `address:port` receiving the request, like 10.0.0.1:443")
+	effective := flags.Bool("effective", false, "show the effective configuration of the location")
+	flags.Parse(args)
+	if flags.NArg() != 3 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	tree, err := loadConfig(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	// position returns the file and the line of a directive.
+	position := func(d *nginx.DirectiveNode) string {
+		start := d.Span().Start
+		return fmt.Sprintf("%s:%d", start.Filename, start.Line)
+	}
+	var route *nginx.Route
+	if *listen != "" {
+		route, err = routeListen(tree, *listen, flags.Arg(1), flags.Arg(2))
+	} else {
+		route, err = nginx.FindRoute(tree, flags.Arg(1), flags.Arg(2))
+	}
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "server\t%s\t%s\n", position(route.Server), serverSummary(route.Server))
+	if route.Location != nil {
+		fmt.Fprintf(w, "location\t%s\t%s\n", position(route.Location.Directive), summary(route.Location.Directive))
+	} else {
+		fmt.Fprintf(w, "location\t-\tno location matches the URI\n")
+	}
+	if len(route.Candidates) > 0 {
+		fmt.Fprintf(w, "\ncandidates:\n")
+	}
+	for _, c := range route.Candidates {
+		matched := "no"
+		if c.Matched {
+			matched = "yes"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", position(c.Location.Directive), summary(c.Location.Directive), matched, c.Reason)
+	}
+
+	if *effective {
+		block := route.Server
+		if route.Location != nil {
+			block = route.Location.Directive
+		}
+		e, err := nginx.Effective(tree, block)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "\neffective configuration:\n")
+		for _, s := range e.Settings {
+			fmt.Fprintf(w, "  %s\t%s\n", position(s.Directive), summary(s.Directive))
+		}
+	}
+	return w.Flush()
+}
+
+// routeListen returns the route of a request for host and uri received on listen.
+func routeListen(tree *nginx.Tree, listen, host, uri string) (*nginx.Route, error) {
+	addr, port, err := net.SplitHostPort(listen)
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(port)
+	if err != nil {
+		return nil, fmt.Errorf("invalid port in %q", listen)
+	}
+	vh, err := nginx.NewVirtualHosts(tree)
+	if err != nil {
+		return nil, err
+	}
+	server := vh.Resolve(addr, n, host)
+	if server == nil {
+		return nil, fmt.Errorf("no server listens on %s", listen)
+	}
+	return nginx.FindLocation(server.Directive, uri), nil
+}
+
+// loadConfig parses the configuration in the named file, which can be the output of "nginx -T",
+// together with the files it includes; "-" is the standard input.
+func loadConfig(name string) (*nginx.Tree, error) {
+	var files map[string]string
+	var err error
+	if name == "-" {
+		files, err = nginx.UnpackReader(os.Stdin)
+	} else {
+		files, err = nginx.Unpack(name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	mode := nginx.SkipContextCheck | nginx.AllErrors
+	if text, ok := files[""]; ok {
+		// a single configuration read from the standard input: the includes can't be resolved.
+		t := nginx.New(name)
+		t.Mode = mode
+		return lenient(t.Parse(text))
+	}
+	if _, ok := files[name]; ok {
+		// not a configuration dump: the included files are read from disk.
+		abs, err := filepath.Abs(name)
+		if err != nil {
+			return nil, err
+		}
+		loader := &nginx.Loader{FS: os.DirFS("/"), Mode: mode}
+		return lenient(loader.Load(filepath.ToSlash(abs)))
+	}
+	if len(files) == 0 {
+		return nil, errors.New("empty configuration")
+	}
+	return lenient(nginx.LoadDump(files, mode))
+}
+
+// summary returns a directive with its arguments, without the block.
+func summary(d *nginx.DirectiveNode) string {
+	words := []string{d.Text}
+	for _, a := range d.Args {
+		if a, ok := a.(*nginx.ArgumentNode); ok {
+			words = append(words, a.String())
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// serverSummary returns the server_name directive of a server, if any.
+func serverSummary(server *nginx.DirectiveNode) string {
+	if len(server.Args) == 0 {
+		// a server without a block, accepted in the lenient mode.
+		return "server"
+	}
+	if b, ok := server.Args[len(server.Args)-1].(*nginx.BlockNode); ok {
+		for _, n := range b.List.Nodes {
+			if d, ok := n.(*nginx.DirectiveNode); ok && d.Text == "server_name" {
+				return summary(d)
+			}
+		}
+	}
+	return "server"
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/piger/nginxp/nginx"
+)
+
+func TestServerSummary(t *testing.T) {
+	tree, err := lenient(nginx.ParseBytes("test", []byte("http {\n    server;\n    server {\n        server_name a b;\n    }\n}\n"), nginx.SkipContextCheck|nginx.AllErrors))
+	if err != nil {
+		t.Fatal(err)
+	}
+	http := tree.Root.Nodes[0].(*nginx.DirectiveNode).Args[0].(*nginx.BlockNode)
+	var got []string
+	for _, n := range http.List.Nodes {
+		if d, ok := n.(*nginx.DirectiveNode); ok {
+			got = append(got, serverSummary(d))
+		}
+	}
+	if len(got) != 2 || got[0] != "server" || got[1] != "server_name a b" {
+		t.Errorf("unexpected summaries: %q", got)
+	}
+}
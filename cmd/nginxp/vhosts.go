@@ -0,0 +1,84 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/piger/nginxp/nginx"
+)
+
+const vhostsUsage = `Usage of vhosts: <config>
+
+Vhosts shows the addresses and the ports where nginx listens, with the servers
+listening on each of them, and reports the conflicting server names and the
+default servers chosen implicitly. The configuration is read like by route.
+`
+
+func runVhosts(args []string) error {
+	flags := flag.NewFlagSet("vhosts", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), vhostsUsage)
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	tree, err := loadConfig(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	vh, err := nginx.NewVirtualHosts(tree)
+	if err != nil {
+		return err
+	}
+	position := func(d *nginx.DirectiveNode) string {
+		start := d.Span().Start
+		return fmt.Sprintf("%s:%d", start.Filename, start.Line)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	for i, sock := range vh.Sockets {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s%s\n", sock, socketFlags(sock))
+		for _, s := range sock.Servers {
+			mark := ""
+			switch {
+			case s == sock.Default && sock.ImplicitDefault:
+				mark = "default (implicit)"
+			case s == sock.Default:
+				mark = "default"
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", position(s.Directive), serverSummary(s.Directive), mark)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	for _, c := range vh.Conflicts {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", position(c.Second.Directive), c.Msg)
+	}
+	return nil
+}
+
+// socketFlags returns the parameters of the listen directives of a socket that change how
+// requests are handled.
+func socketFlags(sock *nginx.Socket) string {
+	var s string
+	if sock.SSL {
+		s += " ssl"
+	}
+	if sock.HTTP2 {
+		s += " http2"
+	}
+	if sock.ProxyProtocol {
+		s += " proxy_protocol"
+	}
+	return s
+}
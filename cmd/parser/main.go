@@ -6,20 +6,25 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/piger/nginxp/internal/parse"
+	"github.com/piger/nginxp/nginx"
 )
 
 var (
 	flagTestLexer  = flag.Bool("lex", false, "Show the output of the lexer")
 	flagAllSection = flag.Bool("all", false, "Parse all sections in a configuration dump")
+	flagNoContext  = flag.Bool("no-context", false, "Don't check the context of directives (useful for included files)")
+	flagAllErrors  = flag.Bool("all-errors", false, "Report all the errors instead of stopping at the first one")
+	flagResolve    = flag.Bool("resolve", false, "Resolve include directives, reading the included files from the dump or from disk")
 	flagPlayground = flag.Bool("play", false, "Call the playground function")
 	flagStuff      = flag.Bool("stuff", false, "Run testing stuff")
 )
 
 var usage = func() {
-	fmt.Fprintf(flag.CommandLine.Output(), "Usage of %s: <filename> [section]\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "Usage of %s: <filename> [section]\n\nUse \"-\" as filename to read from the standard input, e.g. the output of \"nginx -T\".\n", os.Args[0])
 	flag.PrintDefaults()
 }
 
@@ -35,11 +40,15 @@ func run() error {
 		section = flag.Arg(1)
 	}
 
-	filesMap, err := parse.Unpack(filename)
+	filesMap, err := unpack(filename)
 	if err != nil {
 		return err
 	}
 
+	if *flagResolve {
+		return resolve(filename, filesMap)
+	}
+
 	switch {
 	case *flagAllSection:
 		for name, contents := range filesMap {
@@ -47,7 +56,7 @@ func run() error {
 			if strings.HasSuffix(name, ".map") {
 				fmt.Print(contents)
 			} else {
-				return play(name, contents)
+				return dump(name, contents)
 			}
 		}
 	case section != "":
@@ -55,7 +64,7 @@ func run() error {
 		if !ok {
 			return fmt.Errorf("section %q not found", section)
 		}
-		return play(section, contents)
+		return dump(section, contents)
 	default:
 		contents, ok := filesMap[filename]
 		if !ok {
@@ -65,35 +74,93 @@ func run() error {
 			}
 			return errors.New("a configuration dump was read but no section was specified")
 		}
-		return play(filename, contents)
+		return dump(filename, contents)
 	}
 
 	return nil
 }
 
-func play(filename string, contents string) error {
+// unpack reads the configuration dump, or the single configuration file, named filename; "-"
+// stands for the standard input.
+func unpack(filename string) (map[string]string, error) {
+	if filename != "-" {
+		return nginx.Unpack(filename)
+	}
+
+	filesMap, err := nginx.UnpackReader(os.Stdin)
+	if err != nil {
+		return nil, err
+	}
+	// a single configuration file is stored with an empty name.
+	if contents, ok := filesMap[""]; ok {
+		delete(filesMap, "")
+		filesMap[filename] = contents
+	}
+	return filesMap, nil
+}
+
+func parseMode() nginx.Mode {
+	var mode nginx.Mode
+	if *flagNoContext {
+		mode |= nginx.SkipContextCheck
+	}
+	if *flagAllErrors {
+		mode |= nginx.AllErrors
+	}
+	return mode
+}
+
+func dump(filename string, contents string) error {
 	if *flagPlayground {
 		parse.LexerPlayground(filename, contents, *flagTestLexer)
 		return nil
-	} else {
-		tree, err := parse.Parse(filename, contents)
-		if err != nil {
-			return err
-		}
+	}
 
-		cfg, err := parse.NewConfiguration(tree)
-		if err != nil {
-			return err
-		}
+	t := nginx.New(filename)
+	t.Mode = parseMode()
 
-		enc := json.NewEncoder(os.Stdout)
-		enc.SetIndent("", "  ")
-		if err := enc.Encode(cfg); err != nil {
+	tree, err := t.Parse(contents)
+	if err != nil {
+		return err
+	}
+	return encode(tree)
+}
+
+// resolve parses a whole configuration, including the files referenced by include directives.
+func resolve(filename string, filesMap map[string]string) error {
+	var tree *nginx.Tree
+	var err error
+
+	if _, ok := filesMap[filename]; ok && filename != "-" {
+		// not a configuration dump: the included files are read from disk.
+		var abs string
+		if abs, err = filepath.Abs(filename); err != nil {
 			return err
 		}
+		loader := &nginx.Loader{FS: os.DirFS("/"), Mode: parseMode()}
+		tree, err = loader.Load(filepath.ToSlash(abs))
+	} else {
+		tree, err = nginx.LoadDump(filesMap, parseMode())
+	}
+	if err != nil {
+		return err
 	}
+	return encode(tree)
+}
 
-	return nil
+func encode(tree *nginx.Tree) error {
+	// the warnings go to the standard error, to keep the JSON output valid.
+	for _, w := range tree.Warnings() {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", strings.ToUpper(w.Severity.String()), w)
+	}
+	cfg, err := nginx.NewConfiguration(tree)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(cfg)
 }
 
 func main() {
@@ -101,7 +168,14 @@ func main() {
 	flag.Parse()
 
 	if err := run(); err != nil {
-		fmt.Printf("ERROR: %s\n", err)
+		var errs nginx.ErrorList
+		if errors.As(err, &errs) {
+			for _, e := range errs {
+				fmt.Printf("%s: %s\n", strings.ToUpper(e.Severity.String()), e)
+			}
+		} else {
+			fmt.Printf("ERROR: %s\n", err)
+		}
 		os.Exit(1)
 	}
 }
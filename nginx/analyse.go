@@ -0,0 +1,135 @@
+package nginx
+
+import (
+	"fmt"
+)
+
+// Directive contains a single nginx configuration directive; it has a number of optional
+// Args, according to the bitmask in bitmask.go, and an optional Block, or Lua code. The
+// blocks of map, geo, split_clients, types and match directives are also available in a
+// structured form.
+type Directive struct {
+	Name         string              `json:"name"`
+	Args         []string            `json:"args"`
+	Block        []*Directive        `json:"block,omitempty"`
+	Lua          string              `json:"lua,omitempty"` // the code of a *_by_lua_block directive.
+	Comments     *Comments           `json:"comments,omitempty"`
+	Map          *Map                `json:"map,omitempty"`
+	Geo          *Geo                `json:"geo,omitempty"`
+	SplitClients *SplitClients       `json:"split_clients,omitempty"`
+	Types        map[string][]string `json:"types,omitempty"` // the file extensions of each MIME type.
+	Match        *Match              `json:"match,omitempty"`
+	Node         *DirectiveNode      `json:"-"` // the node the directive comes from.
+}
+
+// Comments contains the text of the comments attached to a directive, without the leading '#'.
+type Comments struct {
+	Leading  []string `json:"leading,omitempty"`
+	Trailing string   `json:"trailing,omitempty"`
+	Inline   []string `json:"inline,omitempty"`
+}
+
+// Configuration contains the nginx configuration from a single configuration file.
+type Configuration struct {
+	Filename   string       `json:"filename"`
+	Directives []*Directive `json:"directives"`
+}
+
+// NewConfiguration creates a Configuration from a parsed Tree.
+func NewConfiguration(tree *Tree) (*Configuration, error) {
+	cfg := &Configuration{
+		Filename: tree.Filename,
+	}
+
+	for _, nodeRaw := range tree.Root.Nodes {
+		switch node := nodeRaw.(type) {
+		case *DirectiveNode:
+			d, err := iterateDirective(node, true)
+			if err != nil {
+				return nil, err
+			}
+			cfg.Directives = append(cfg.Directives, d)
+		case *CommentNode, *EmptyLineNode:
+			continue
+		default:
+			panic(fmt.Sprintf("Unhandled node type: %s\n", node.Type()))
+		}
+	}
+	return cfg, nil
+}
+
+// iterateDirective converts node and its block; decode is false for the entries in the blocks of
+// map, geo and the other directives in skipValidation, which are not directives even when their
+// keys look like one, as in "match search;" in a map.
+func iterateDirective(node *DirectiveNode, decode bool) (*Directive, error) {
+	d := &Directive{Name: node.String(), Args: []string{}, Node: node}
+
+	if len(node.Leading) > 0 || node.Trailing != nil || len(node.Inline) > 0 {
+		d.Comments = &Comments{}
+		for _, c := range node.Leading {
+			d.Comments.Leading = append(d.Comments.Leading, c.Text)
+		}
+		if node.Trailing != nil {
+			d.Comments.Trailing = node.Trailing.Text
+		}
+		for _, c := range node.Inline {
+			d.Comments.Inline = append(d.Comments.Inline, c.Text)
+		}
+	}
+
+	for _, argRaw := range node.Args {
+		switch arg := argRaw.(type) {
+		case *ArgumentNode:
+			d.Args = append(d.Args, arg.Value)
+		case *BlockNode:
+			dirs, err := iterateBlock(arg, decode && !skipValidation[d.Name])
+			if err != nil {
+				return nil, err
+			}
+			d.Block = dirs
+		case *BlockLua:
+			d.Lua = arg.Code()
+		}
+	}
+
+	if !decode {
+		return d, nil
+	}
+	var err error
+	switch d.Name {
+	case "map":
+		d.Map, err = NewMap(node)
+	case "geo":
+		d.Geo, err = NewGeo(node)
+	case "split_clients":
+		d.SplitClients, err = NewSplitClients(node)
+	case "types":
+		d.Types, err = NewTypes(node)
+	case "match":
+		d.Match, err = NewMatch(node)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func iterateBlock(node *BlockNode, decode bool) ([]*Directive, error) {
+	var dirs []*Directive
+
+	for _, nodeRaw := range node.List.Nodes {
+		switch subNode := nodeRaw.(type) {
+		case *DirectiveNode:
+			d, err := iterateDirective(subNode, decode)
+			if err != nil {
+				return nil, err
+			}
+			dirs = append(dirs, d)
+		case *CommentNode, *EmptyLineNode:
+			continue
+		default:
+			panic(fmt.Sprintf("unexpected node type in block: %s\n", subNode.Type()))
+		}
+	}
+	return dirs, nil
+}
@@ -0,0 +1,242 @@
+package nginx
+
+import "strings"
+
+// QuoteStyle is the quoting of an argument.
+type QuoteStyle int
+
+const (
+	QuoteNone   QuoteStyle = iota // the argument is not quoted.
+	QuoteSingle                   // the argument is enclosed in single quotes.
+	QuoteDouble                   // the argument is enclosed in double quotes.
+)
+
+func (q QuoteStyle) String() string {
+	switch q {
+	case QuoteSingle:
+		return "single"
+	case QuoteDouble:
+		return "double"
+	}
+	return "none"
+}
+
+// unquoteArg returns the value of the raw text of an argument and its quote style. Like nginx,
+// it removes the surrounding quotes and decodes the escape sequences \", \', \\, \t, \r and \n,
+// both inside and outside quotes; any other backslash is part of the value.
+func unquoteArg(s string) (string, QuoteStyle) {
+	quote := QuoteNone
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		quote = QuoteDouble
+		if s[0] == '\'' {
+			quote = QuoteSingle
+		}
+		s = s[1 : len(s)-1]
+	}
+	if !strings.Contains(s, `\`) {
+		return s, quote
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			switch s[i+1] {
+			case '"', '\'', '\\':
+				b.WriteByte(s[i+1])
+				i++
+				continue
+			case 't':
+				b.WriteByte('\t')
+				i++
+				continue
+			case 'r':
+				b.WriteByte('\r')
+				i++
+				continue
+			case 'n':
+				b.WriteByte('\n')
+				i++
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String(), quote
+}
+
+// quoteArg returns the raw text of an argument whose value is v, using the given quote style;
+// an unquoted value that can't be written as a single word is enclosed in double quotes.
+func quoteArg(v string, quote QuoteStyle) string {
+	if quote == QuoteNone && needsQuotes(v) {
+		quote = QuoteDouble
+	}
+
+	var b strings.Builder
+	b.Grow(len(v) + 2)
+	marker := byte(0)
+	switch quote {
+	case QuoteSingle:
+		marker = '\''
+	case QuoteDouble:
+		marker = '"'
+	}
+	if marker != 0 {
+		b.WriteByte(marker)
+	}
+	for i := 0; i < len(v); i++ {
+		switch c := v[i]; {
+		case c == '\\':
+			// a backslash is escaped only when it would start an escape sequence.
+			if i+1 == len(v) || strings.IndexByte(`"'\trn`, v[i+1]) >= 0 {
+				b.WriteByte('\\')
+			}
+			b.WriteByte(c)
+		case marker != 0 && c == marker:
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c == '\n':
+			b.WriteString(`\n`)
+		case c == '\r':
+			b.WriteString(`\r`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if marker != 0 {
+		b.WriteByte(marker)
+	}
+	return b.String()
+}
+
+// needsQuotes reports whether v must be quoted to be read back as a single argument: it's empty,
+// it starts with a quote or it contains whitespace or one of the characters ending a word or
+// starting a comment or a block.
+func needsQuotes(v string) bool {
+	return v == "" || v[0] == '"' || v[0] == '\'' || strings.ContainsAny(v, " \t\r\n;{}#")
+}
+
+// Part is a piece of the value of an argument: either literal text or a reference to a variable.
+type Part struct {
+	Text     string // the text of the part; for a variable, it includes '$' and the brackets.
+	Variable string // the name of the variable, or the number of a regex capture; empty for text.
+	Offset   int    // the offset of the part in the value, in bytes.
+}
+
+// IsVariable reports whether the part is a reference to a variable or to a regex capture.
+func (p Part) IsVariable() bool {
+	return p.Variable != ""
+}
+
+// IsCapture reports whether the part is a reference to a regex capture, from $1 to $9.
+func (p Part) IsCapture() bool {
+	return len(p.Variable) == 1 && p.Variable[0] >= '1' && p.Variable[0] <= '9'
+}
+
+// SplitVariables splits a value into literal text and variable references, the way nginx
+// compiles a complex value: '$' followed by a digit from 1 to 9 is a regex capture, while
+// "$name" and "${name}" are variables, whose names are made of letters, digits and '_'. A '$'
+// that is not followed by a valid reference, like an unclosed "${", is part of the text.
+func SplitVariables(value string) []Part {
+	var parts []Part
+	text := 0 // start of the pending text.
+	flush := func(i int) {
+		if i > text {
+			parts = append(parts, Part{Text: value[text:i], Offset: text})
+		}
+	}
+
+	for i := 0; i < len(value); {
+		if value[i] != '$' || i+1 == len(value) {
+			i++
+			continue
+		}
+		start := i
+		var name string
+		switch c := value[i+1]; {
+		case c >= '1' && c <= '9':
+			name = value[i+1 : i+2]
+			i += 2
+		case c == '{':
+			j := i + 2
+			for j < len(value) && isNameChar(value[j]) {
+				j++
+			}
+			if j == i+2 || j == len(value) || value[j] != '}' {
+				i++
+				continue
+			}
+			name = value[i+2 : j]
+			i = j + 1
+		default:
+			j := i + 1
+			for j < len(value) && isNameChar(value[j]) {
+				j++
+			}
+			if j == i+1 {
+				i++
+				continue
+			}
+			name = value[i+1 : j]
+			i = j
+		}
+		flush(start)
+		parts = append(parts, Part{Text: value[start:i], Variable: name, Offset: start})
+		text = i
+	}
+	flush(len(value))
+	return parts
+}
+
+// Parts returns the literal text and the variable references in the value of the argument.
+func (a *ArgumentNode) Parts() []Part {
+	return SplitVariables(a.Value)
+}
+
+// RenameVariable replaces the references to the variable old in the value of the argument with
+// references to the variable new, and reports whether the value changed. The brackets are added
+// when the new name would otherwise run into the following text. Nothing changes when new is
+// not a valid name, made of letters, digits and '_' and not starting with a digit, which would
+// make it a capture, like $1.
+func (a *ArgumentNode) RenameVariable(old, new string) bool {
+	if new == "" || isDigit(new[0]) {
+		return false
+	}
+	for i := 0; i < len(new); i++ {
+		if !isNameChar(new[i]) {
+			return false
+		}
+	}
+	parts := a.Parts()
+	var b strings.Builder
+	changed := false
+	for i, p := range parts {
+		if p.Variable != old {
+			b.WriteString(p.Text)
+			continue
+		}
+		changed = true
+		braces := strings.HasPrefix(p.Text, "${")
+		if i+1 < len(parts) && !parts[i+1].IsVariable() && isNameChar(parts[i+1].Text[0]) {
+			braces = true
+		}
+		if braces {
+			b.WriteString("${" + new + "}")
+		} else {
+			b.WriteString("$" + new)
+		}
+	}
+	if changed {
+		a.Value = b.String()
+	}
+	return changed
+}
+
+// isNameChar reports whether c can be part of the name of a variable.
+func isNameChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || isDigit(c) || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,180 @@
+package nginx
+
+import "testing"
+
+func TestUnquoteArg(t *testing.T) {
+	var tests = []struct {
+		raw   string
+		value string
+		quote QuoteStyle
+	}{
+		{`on`, `on`, QuoteNone},
+		{`"a b"`, `a b`, QuoteDouble},
+		{`'it\'s a me'`, `it's a me`, QuoteSingle},
+		{`"say \"hi\""`, `say "hi"`, QuoteDouble},
+		{`'\t\r\n\\'`, "\t\r\n\\", QuoteSingle},
+		{`^/(\d+)\.html$`, `^/(\d+)\.html$`, QuoteNone},
+		{`a\"b`, `a"b`, QuoteNone},
+		{`""`, ``, QuoteDouble},
+		{`"`, `"`, QuoteNone},
+		{`'a"`, `'a"`, QuoteNone},
+	}
+	for _, tt := range tests {
+		value, quote := unquoteArg(tt.raw)
+		if value != tt.value || quote != tt.quote {
+			t.Errorf("unquoteArg(%s) = %q, %s; expected %q, %s", tt.raw, value, quote, tt.value, tt.quote)
+		}
+	}
+}
+
+func TestQuoteArg(t *testing.T) {
+	var tests = []struct {
+		value string
+		quote QuoteStyle
+		raw   string
+	}{
+		{`on`, QuoteNone, `on`},
+		{`on`, QuoteSingle, `'on'`},
+		{``, QuoteNone, `""`},
+		{`a b`, QuoteNone, `"a b"`},
+		{`a;b`, QuoteNone, `"a;b"`},
+		{`a{b`, QuoteNone, `"a{b"`},
+		{`#a`, QuoteNone, `"#a"`},
+		{`it's`, QuoteSingle, `'it\'s'`},
+		{`it's`, QuoteDouble, `"it's"`},
+		{`say "hi"`, QuoteNone, `"say \"hi\""`},
+		{`'a'`, QuoteNone, `"'a'"`},
+		{`^/(\d+)$`, QuoteNone, `^/(\d+)$`},
+		{`a\nb`, QuoteNone, `a\\nb`},
+		{"a\nb", QuoteDouble, `"a\nb"`},
+		{`a\`, QuoteDouble, `"a\\"`},
+	}
+	for _, tt := range tests {
+		raw := quoteArg(tt.value, tt.quote)
+		if raw != tt.raw {
+			t.Errorf("quoteArg(%q, %s) = %s; expected %s", tt.value, tt.quote, raw, tt.raw)
+		}
+		if value, _ := unquoteArg(raw); value != tt.value {
+			t.Errorf("%s is read back as %q; expected %q", raw, value, tt.value)
+		}
+	}
+}
+
+func TestArgumentValue(t *testing.T) {
+	tree := New("test")
+	tree.Mode = SkipContextCheck
+	tree, err := tree.Parse(`add_header X-Test 'it\'s a me';`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	arg := tree.Root.Nodes[0].(*DirectiveNode).Args[1].(*ArgumentNode)
+	if arg.Raw() != `'it\'s a me'` || arg.Value != "it's a me" || arg.Quote != QuoteSingle {
+		t.Fatalf("unexpected argument: %s, %q, %s", arg.Raw(), arg.Value, arg.Quote)
+	}
+
+	arg.Value = "it's; me"
+	if got := arg.String(); got != `'it\'s; me'` {
+		t.Errorf("unexpected argument after changing the value: %s", got)
+	}
+	arg.Quote = QuoteNone
+	if got := arg.String(); got != `"it's; me"` {
+		t.Errorf("unexpected argument after removing the quotes: %s", got)
+	}
+	if arg.Raw() != `'it\'s a me'` {
+		t.Errorf("the raw text changed: %s", arg.Raw())
+	}
+
+	// the configuration and the printer see the same value.
+	cfg, err := NewConfiguration(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Directives[0].Args[1]; got != "it's; me" {
+		t.Errorf("got configuration argument %q, want %q", got, "it's; me")
+	}
+}
+
+func TestSplitVariables(t *testing.T) {
+	var tests = []struct {
+		value    string
+		expected []Part
+	}{
+		{"", nil},
+		{"plain", []Part{{"plain", "", 0}}},
+		{"https://$server_name$request_uri", []Part{
+			{"https://", "", 0},
+			{"$server_name", "server_name", 8},
+			{"$request_uri", "request_uri", 20},
+		}},
+		{"a_word_with${variable}s", []Part{
+			{"a_word_with", "", 0},
+			{"${variable}", "variable", 11},
+			{"s", "", 22},
+		}},
+		{"/img/$1/$22.png", []Part{
+			{"/img/", "", 0},
+			{"$1", "1", 5},
+			{"/", "", 7},
+			{"$2", "2", 8},
+			{"2.png", "", 10},
+		}},
+		{"^/(.*)$", []Part{{"^/(.*)$", "", 0}}},
+		{"$ ${ ${} ${a $-", []Part{{"$ ${ ${} ${a $-", "", 0}}},
+		{"$0", []Part{{"$0", "0", 0}}},
+	}
+	for _, tt := range tests {
+		parts := SplitVariables(tt.value)
+		if len(parts) != len(tt.expected) {
+			t.Errorf("SplitVariables(%q) = %+v; expected %+v", tt.value, parts, tt.expected)
+			continue
+		}
+		for i := range parts {
+			if parts[i] != tt.expected[i] {
+				t.Errorf("SplitVariables(%q) = %+v; expected %+v", tt.value, parts, tt.expected)
+				break
+			}
+		}
+	}
+}
+
+func TestIsCapture(t *testing.T) {
+	var tests = []struct {
+		value   string
+		capture bool
+	}{
+		{"$1", true},
+		{"$9", true},
+		{"$0", false},
+		{"${12}", false},
+		{"$host", false},
+		{"text", false},
+	}
+	for _, tt := range tests {
+		if got := SplitVariables(tt.value)[0].IsCapture(); got != tt.capture {
+			t.Errorf("IsCapture(%q) = %v; expected %v", tt.value, got, tt.capture)
+		}
+	}
+}
+
+func TestRenameVariable(t *testing.T) {
+	var tests = []struct {
+		value, old, new string
+		expected        string
+	}{
+		{"$host$uri", "host", "server_name", "$server_name$uri"},
+		{"${host}.example.com", "host", "h", "${h}.example.com"},
+		{"$1x $1", "1", "name", "${name}x $name"},
+		{"$hostname", "host", "h", "$hostname"},
+		{"$host", "host", "a-b", "$host"},
+		{"$host", "host", "", "$host"},
+		{"$foo/x", "foo", "1abc", "$foo/x"},
+		{"$foo", "foo", "1", "$foo"},
+	}
+	for _, tt := range tests {
+		arg := NewArgument(tt.value)
+		changed := arg.RenameVariable(tt.old, tt.new)
+		if arg.Value != tt.expected || changed != (tt.value != tt.expected) {
+			t.Errorf("renaming %s to %s in %q: got %q, %v; expected %q", tt.old, tt.new, tt.value, arg.Value, changed, tt.expected)
+		}
+	}
+}
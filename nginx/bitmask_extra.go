@@ -0,0 +1,44 @@
+package nginx
+
+// Here we can add additional directives that are not present in crossplane.
+
+func init() {
+	// Opentracing
+	// https://github.com/opentracing-contrib/nginx-opentracing/blob/master/doc/Reference.md
+	dirMask["opentracing"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG}
+	dirMask["opentracing_load_tracer"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE2}
+	dirMask["opentracing_propagate_context"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS}
+	dirMask["opentracing_tag"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2}
+	dirMask["opentracing_operation_name"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1}
+	dirMask["opentracing_trace_locations"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG}
+
+	// ngx_http_js_module
+	// http://nginx.org/en/docs/http/ngx_http_js_module.html
+	dirMask["js_import"] = []int{ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE13}
+	dirMask["js_set"] = []int{ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE12}
+
+	// lua
+	// https://github.com/openresty/lua-nginx-module
+	dirMask["rewrite_by_lua_file"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1}
+	dirMask["access_by_lua_file"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1}
+	dirMask["access_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["init_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_STREAM_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["init_worker_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_STREAM_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["exit_worker_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["set_by_lua_block"] = []int{ngx_HTTP_SRV_CONF | ngx_HTTP_SIF_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE1}
+	dirMask["server_rewrite_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["rewrite_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["content_by_lua_block"] = []int{ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_STREAM_SRV_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["header_filter_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["body_filter_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["log_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["balancer_by_lua_block"] = []int{ngx_HTTP_UPS_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_STREAM_UPS_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["ssl_client_hello_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["ssl_certificate_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["ssl_session_fetch_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+	dirMask["ssl_session_store_by_lua_block"] = []int{ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+
+	// stream-lua-nginx-module
+	// https://github.com/openresty/stream-lua-nginx-module
+	dirMask["preread_by_lua_block"] = []int{ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS}
+}
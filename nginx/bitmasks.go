@@ -0,0 +1,680 @@
+package nginx
+
+// THIS FILE IS AUTOGENERATED
+
+const (
+	ngx_CONF_NOARGS      = 0x00000001 // 0 args
+	ngx_CONF_TAKE1       = 0x00000002 // 1 args
+	ngx_CONF_TAKE2       = 0x00000004 // 2 args
+	ngx_CONF_TAKE3       = 0x00000008 // 3 args
+	ngx_CONF_TAKE4       = 0x00000010 // 4 args
+	ngx_CONF_TAKE5       = 0x00000020 // 5 args
+	ngx_CONF_TAKE6       = 0x00000040 // 6 args
+	ngx_CONF_TAKE7       = 0x00000080 // 7 args
+	ngx_CONF_BLOCK       = 0x00000100 // followed by block
+	ngx_CONF_FLAG        = 0x00000200 // 'on' or 'off'
+	ngx_CONF_ANY         = 0x00000400 // >=0 args
+	ngx_CONF_1MORE       = 0x00000800 // >=1 args
+	ngx_CONF_2MORE       = 0x00001000 // >=2 args
+	ngx_CONF_TAKE12      = (ngx_CONF_TAKE1 | ngx_CONF_TAKE2)
+	ngx_CONF_TAKE13      = (ngx_CONF_TAKE1 | ngx_CONF_TAKE3)
+	ngx_CONF_TAKE23      = (ngx_CONF_TAKE2 | ngx_CONF_TAKE3)
+	ngx_CONF_TAKE34      = (ngx_CONF_TAKE3 | ngx_CONF_TAKE4)
+	ngx_CONF_TAKE123     = (ngx_CONF_TAKE12 | ngx_CONF_TAKE3)
+	ngx_CONF_TAKE1234    = (ngx_CONF_TAKE123 | ngx_CONF_TAKE4)
+	ngx_DIRECT_CONF      = 0x00010000 // main file (not used)
+	ngx_MAIN_CONF        = 0x00040000 // main context
+	ngx_EVENT_CONF       = 0x00080000 // events
+	ngx_MAIL_MAIN_CONF   = 0x00100000 // mail
+	ngx_MAIL_SRV_CONF    = 0x00200000 // mail > server
+	ngx_STREAM_MAIN_CONF = 0x00400000 // stream
+	ngx_STREAM_SRV_CONF  = 0x00800000 // stream > server
+	ngx_STREAM_UPS_CONF  = 0x01000000 // stream > upstream
+	ngx_HTTP_MAIN_CONF   = 0x02000000 // http
+	ngx_HTTP_SRV_CONF    = 0x04000000 // http > server
+	ngx_HTTP_LOC_CONF    = 0x08000000 // http > location
+	ngx_HTTP_UPS_CONF    = 0x10000000 // http > upstream
+	ngx_HTTP_SIF_CONF    = 0x20000000 // http > server > if
+	ngx_HTTP_LIF_CONF    = 0x40000000 // http > location > if
+	ngx_HTTP_LMT_CONF    = 0x80000000 // http > location > limit_except
+	ngx_ANY_CONF         = (ngx_MAIN_CONF | ngx_EVENT_CONF | ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF |
+		ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_STREAM_UPS_CONF |
+		ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_UPS_CONF)
+)
+
+var dirMask = map[string][]int{
+	"absolute_redirect":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"accept_mutex":                      {ngx_EVENT_CONF | ngx_CONF_FLAG},
+	"accept_mutex_delay":                {ngx_EVENT_CONF | ngx_CONF_TAKE1},
+	"access_log":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_HTTP_LMT_CONF | ngx_CONF_1MORE, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_1MORE},
+	"add_after_body":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"add_before_body":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"add_header":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE23},
+	"add_trailer":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE23},
+	"addition_types":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"aio":                               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"aio_write":                         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"alias":                             {ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"allow":                             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LMT_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ancient_browser":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"ancient_browser_value":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"auth_basic":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LMT_CONF | ngx_CONF_TAKE1},
+	"auth_basic_user_file":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LMT_CONF | ngx_CONF_TAKE1},
+	"auth_http":                         {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"auth_http_header":                  {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE2},
+	"auth_http_pass_client_cert":        {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_FLAG},
+	"auth_http_timeout":                 {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"auth_request":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"auth_request_set":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"autoindex":                         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"autoindex_exact_size":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"autoindex_format":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"autoindex_localtime":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"break":                             {ngx_HTTP_SRV_CONF | ngx_HTTP_SIF_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_NOARGS},
+	"charset":                           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"charset_map":                       {ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE2},
+	"charset_types":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"chunked_transfer_encoding":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"client_body_buffer_size":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"client_body_in_file_only":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"client_body_in_single_buffer":      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"client_body_temp_path":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1234},
+	"client_body_timeout":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"client_header_buffer_size":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"client_header_timeout":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"client_max_body_size":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"connection_pool_size":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"create_full_put_path":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"daemon":                            {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_FLAG},
+	"dav_access":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE123},
+	"dav_methods":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"debug_connection":                  {ngx_EVENT_CONF | ngx_CONF_TAKE1},
+	"debug_points":                      {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"default_type":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"deny":                              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LMT_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"directio":                          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"directio_alignment":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"disable_symlinks":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"empty_gif":                         {ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS},
+	"env":                               {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"error_log":                         {ngx_MAIN_CONF | ngx_CONF_1MORE, ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_1MORE, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_1MORE},
+	"error_page":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_2MORE},
+	"etag":                              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"events":                            {ngx_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS},
+	"expires":                           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE12},
+	"fastcgi_bind":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"fastcgi_buffer_size":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_buffering":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_buffers":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"fastcgi_busy_buffers_size":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_cache":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_cache_background_update":   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_cache_bypass":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"fastcgi_cache_key":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_cache_lock":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_cache_lock_age":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_cache_lock_timeout":        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_cache_max_range_offset":    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_cache_methods":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"fastcgi_cache_min_uses":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_cache_path":                {ngx_HTTP_MAIN_CONF | ngx_CONF_2MORE},
+	"fastcgi_cache_revalidate":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_cache_use_stale":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"fastcgi_cache_valid":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"fastcgi_catch_stderr":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_connect_timeout":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_force_ranges":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_hide_header":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_ignore_client_abort":       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_ignore_headers":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"fastcgi_index":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_intercept_errors":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_keep_conn":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_limit_rate":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_max_temp_file_size":        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_next_upstream":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"fastcgi_next_upstream_timeout":     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_next_upstream_tries":       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_no_cache":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"fastcgi_param":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE23},
+	"fastcgi_pass":                      {ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"fastcgi_pass_header":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_pass_request_body":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_pass_request_headers":      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_read_timeout":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_request_buffering":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_send_lowat":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_send_timeout":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_socket_keepalive":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"fastcgi_split_path_info":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_store":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_store_access":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE123},
+	"fastcgi_temp_file_write_size":      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_temp_path":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1234},
+	"flv":                               {ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS},
+	"geo":                               {ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE12, ngx_STREAM_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE12},
+	"geoip_city":                        {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE12, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE12},
+	"geoip_country":                     {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE12, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE12},
+	"geoip_org":                         {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE12, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE12},
+	"geoip_proxy":                       {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1},
+	"geoip_proxy_recursive":             {ngx_HTTP_MAIN_CONF | ngx_CONF_FLAG},
+	"google_perftools_profiles":         {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"grpc_bind":                         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"grpc_buffer_size":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_connect_timeout":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_hide_header":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_ignore_headers":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"grpc_intercept_errors":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"grpc_next_upstream":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"grpc_next_upstream_timeout":        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_next_upstream_tries":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_pass":                         {ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"grpc_pass_header":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_read_timeout":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_send_timeout":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_set_header":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"grpc_socket_keepalive":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"grpc_ssl_certificate":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_ssl_certificate_key":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_ssl_ciphers":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_ssl_crl":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_ssl_name":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_ssl_password_file":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_ssl_protocols":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"grpc_ssl_server_name":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"grpc_ssl_session_reuse":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"grpc_ssl_trusted_certificate":      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"grpc_ssl_verify":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"grpc_ssl_verify_depth":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"gunzip":                            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"gunzip_buffers":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"gzip":                              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_FLAG},
+	"gzip_buffers":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"gzip_comp_level":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"gzip_disable":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"gzip_http_version":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"gzip_min_length":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"gzip_proxied":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"gzip_static":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"gzip_types":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"gzip_vary":                         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"hash":                              {ngx_HTTP_UPS_CONF | ngx_CONF_TAKE12, ngx_STREAM_UPS_CONF | ngx_CONF_TAKE12},
+	"http":                              {ngx_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS},
+	"http2_body_preread_size":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"http2_chunk_size":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"http2_idle_timeout":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"http2_max_concurrent_pushes":       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"http2_max_concurrent_streams":      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"http2_max_field_size":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"http2_max_header_size":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"http2_max_requests":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"http2_push":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"http2_push_preload":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"http2_recv_buffer_size":            {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1},
+	"http2_recv_timeout":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"if":                                {ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_BLOCK | ngx_CONF_1MORE},
+	"if_modified_since":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"ignore_invalid_headers":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_FLAG},
+	"image_filter":                      {ngx_HTTP_LOC_CONF | ngx_CONF_TAKE123},
+	"image_filter_buffer":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"image_filter_interlace":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"image_filter_jpeg_quality":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"image_filter_sharpen":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"image_filter_transparency":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"image_filter_webp_quality":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"imap_auth":                         {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_1MORE},
+	"imap_capabilities":                 {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_1MORE},
+	"imap_client_buffer":                {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"include":                           {ngx_ANY_CONF | ngx_CONF_TAKE1},
+	"index":                             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"internal":                          {ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS},
+	"ip_hash":                           {ngx_HTTP_UPS_CONF | ngx_CONF_NOARGS},
+	"keepalive":                         {ngx_HTTP_UPS_CONF | ngx_CONF_TAKE1},
+	"keepalive_disable":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"keepalive_requests":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_HTTP_UPS_CONF | ngx_CONF_TAKE1},
+	"keepalive_timeout":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12, ngx_HTTP_UPS_CONF | ngx_CONF_TAKE1},
+	"large_client_header_buffers":       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE2},
+	"least_conn":                        {ngx_HTTP_UPS_CONF | ngx_CONF_NOARGS, ngx_STREAM_UPS_CONF | ngx_CONF_NOARGS},
+	"limit_conn":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE2},
+	"limit_conn_dry_run":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"limit_conn_log_level":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"limit_conn_status":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"limit_conn_zone":                   {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE2, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE2},
+	"limit_except":                      {ngx_HTTP_LOC_CONF | ngx_CONF_BLOCK | ngx_CONF_1MORE},
+	"limit_rate":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"limit_rate_after":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"limit_req":                         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE123},
+	"limit_req_dry_run":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"limit_req_log_level":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"limit_req_status":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"limit_req_zone":                    {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE34},
+	"lingering_close":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"lingering_time":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"lingering_timeout":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"listen":                            {ngx_HTTP_SRV_CONF | ngx_CONF_1MORE, ngx_MAIL_SRV_CONF | ngx_CONF_1MORE, ngx_STREAM_SRV_CONF | ngx_CONF_1MORE},
+	"load_module":                       {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"location":                          {ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE12},
+	"lock_file":                         {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"log_format":                        {ngx_HTTP_MAIN_CONF | ngx_CONF_2MORE, ngx_STREAM_MAIN_CONF | ngx_CONF_2MORE},
+	"log_not_found":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"log_subrequest":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"mail":                              {ngx_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS},
+	"map":                               {ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE2, ngx_STREAM_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE2},
+	"map_hash_bucket_size":              {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE1},
+	"map_hash_max_size":                 {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE1},
+	"master_process":                    {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_FLAG},
+	"max_ranges":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"memcached_bind":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"memcached_buffer_size":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"memcached_connect_timeout":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"memcached_gzip_flag":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"memcached_next_upstream":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"memcached_next_upstream_timeout":   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"memcached_next_upstream_tries":     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"memcached_pass":                    {ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"memcached_read_timeout":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"memcached_send_timeout":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"memcached_socket_keepalive":        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"merge_slashes":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_FLAG},
+	"min_delete_depth":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"mirror":                            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"mirror_request_body":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"modern_browser":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"modern_browser_value":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"mp4":                               {ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS},
+	"mp4_buffer_size":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"mp4_max_buffer_size":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"msie_padding":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"msie_refresh":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"multi_accept":                      {ngx_EVENT_CONF | ngx_CONF_FLAG},
+	"open_file_cache":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"open_file_cache_errors":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"open_file_cache_min_uses":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"open_file_cache_valid":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"open_log_file_cache":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1234, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1234},
+	"output_buffers":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"override_charset":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_FLAG},
+	"pcre_jit":                          {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_FLAG},
+	"perl":                              {ngx_HTTP_LOC_CONF | ngx_HTTP_LMT_CONF | ngx_CONF_TAKE1},
+	"perl_modules":                      {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1},
+	"perl_require":                      {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1},
+	"perl_set":                          {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE2},
+	"pid":                               {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"pop3_auth":                         {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_1MORE},
+	"pop3_capabilities":                 {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_1MORE},
+	"port_in_redirect":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"postpone_output":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"preread_buffer_size":               {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"preread_timeout":                   {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"protocol":                          {ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_bind":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE12},
+	"proxy_buffer":                      {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_buffer_size":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_buffering":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_buffers":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"proxy_busy_buffers_size":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_cache":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_cache_background_update":     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_cache_bypass":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"proxy_cache_convert_head":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_cache_key":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_cache_lock":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_cache_lock_age":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_cache_lock_timeout":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_cache_max_range_offset":      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_cache_methods":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"proxy_cache_min_uses":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_cache_path":                  {ngx_HTTP_MAIN_CONF | ngx_CONF_2MORE},
+	"proxy_cache_revalidate":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_cache_use_stale":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"proxy_cache_valid":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"proxy_connect_timeout":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_cookie_domain":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"proxy_cookie_path":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"proxy_download_rate":               {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_force_ranges":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_headers_hash_bucket_size":    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_headers_hash_max_size":       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_hide_header":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_http_version":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_ignore_client_abort":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_ignore_headers":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"proxy_intercept_errors":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_limit_rate":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_max_temp_file_size":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_method":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_next_upstream":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"proxy_next_upstream_timeout":       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_next_upstream_tries":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_no_cache":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"proxy_pass":                        {ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_HTTP_LMT_CONF | ngx_CONF_TAKE1, ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_pass_error_message":          {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_FLAG},
+	"proxy_pass_header":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_pass_request_body":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_pass_request_headers":        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_protocol":                    {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"proxy_protocol_timeout":            {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_read_timeout":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_redirect":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"proxy_request_buffering":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"proxy_requests":                    {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_responses":                   {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_send_lowat":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_send_timeout":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_set_body":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_set_header":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"proxy_socket_keepalive":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"proxy_ssl":                         {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"proxy_ssl_certificate":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_ssl_certificate_key":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_ssl_ciphers":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_ssl_crl":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_ssl_name":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_ssl_password_file":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_ssl_protocols":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_1MORE},
+	"proxy_ssl_server_name":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"proxy_ssl_session_reuse":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"proxy_ssl_trusted_certificate":     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_ssl_verify":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"proxy_ssl_verify_depth":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_store":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_store_access":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE123},
+	"proxy_temp_file_write_size":        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"proxy_temp_path":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1234},
+	"proxy_timeout":                     {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"proxy_upload_rate":                 {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"random":                            {ngx_HTTP_UPS_CONF | ngx_CONF_NOARGS | ngx_CONF_TAKE12, ngx_STREAM_UPS_CONF | ngx_CONF_NOARGS | ngx_CONF_TAKE12},
+	"random_index":                      {ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"read_ahead":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"real_ip_header":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"real_ip_recursive":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"recursive_error_pages":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"referer_hash_bucket_size":          {ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"referer_hash_max_size":             {ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"request_pool_size":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"reset_timedout_connection":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"resolver":                          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE, ngx_HTTP_UPS_CONF | ngx_CONF_1MORE, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_1MORE, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_1MORE},
+	"resolver_timeout":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"return":                            {ngx_HTTP_SRV_CONF | ngx_HTTP_SIF_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE12, ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"rewrite":                           {ngx_HTTP_SRV_CONF | ngx_HTTP_SIF_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE23},
+	"rewrite_log":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_SIF_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_FLAG},
+	"root":                              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"satisfy":                           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_bind":                         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"scgi_buffer_size":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_buffering":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_buffers":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"scgi_busy_buffers_size":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_cache":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_cache_background_update":      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_cache_bypass":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"scgi_cache_key":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_cache_lock":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_cache_lock_age":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_cache_lock_timeout":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_cache_max_range_offset":       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_cache_methods":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"scgi_cache_min_uses":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_cache_path":                   {ngx_HTTP_MAIN_CONF | ngx_CONF_2MORE},
+	"scgi_cache_revalidate":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_cache_use_stale":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"scgi_cache_valid":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"scgi_connect_timeout":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_force_ranges":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_hide_header":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_ignore_client_abort":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_ignore_headers":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"scgi_intercept_errors":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_limit_rate":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_max_temp_file_size":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_next_upstream":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"scgi_next_upstream_timeout":        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_next_upstream_tries":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_no_cache":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"scgi_param":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE23},
+	"scgi_pass":                         {ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"scgi_pass_header":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_pass_request_body":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_pass_request_headers":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_read_timeout":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_request_buffering":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_send_timeout":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_socket_keepalive":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"scgi_store":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_store_access":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE123},
+	"scgi_temp_file_write_size":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"scgi_temp_path":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1234},
+	"secure_link":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"secure_link_md5":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"secure_link_secret":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"send_lowat":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"send_timeout":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"sendfile":                          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_FLAG},
+	"sendfile_max_chunk":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"server":                            {ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_HTTP_UPS_CONF | ngx_CONF_1MORE, ngx_MAIL_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_STREAM_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS, ngx_STREAM_UPS_CONF | ngx_CONF_1MORE},
+	"server_name":                       {ngx_HTTP_SRV_CONF | ngx_CONF_1MORE, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"server_name_in_redirect":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"server_names_hash_bucket_size":     {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1},
+	"server_names_hash_max_size":        {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1},
+	"server_tokens":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"set":                               {ngx_HTTP_SRV_CONF | ngx_HTTP_SIF_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE2},
+	"set_real_ip_from":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"slice":                             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"smtp_auth":                         {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_1MORE},
+	"smtp_capabilities":                 {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_1MORE},
+	"smtp_client_buffer":                {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"smtp_greeting_delay":               {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"source_charset":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"spdy_chunk_size":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"spdy_headers_comp":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"split_clients":                     {ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE2, ngx_STREAM_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE2},
+	"ssi":                               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_FLAG},
+	"ssi_last_modified":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"ssi_min_file_chunk":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"ssi_silent_errors":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"ssi_types":                         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"ssi_value_length":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"ssl":                               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_FLAG, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_FLAG},
+	"ssl_buffer_size":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_certificate":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_certificate_key":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_ciphers":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_client_certificate":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_crl":                           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_dhparam":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_early_data":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_FLAG},
+	"ssl_ecdh_curve":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_engine":                        {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"ssl_handshake_timeout":             {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_password_file":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_prefer_server_ciphers":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_FLAG, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_FLAG, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"ssl_preread":                       {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"ssl_protocols":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_1MORE, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_1MORE, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_1MORE},
+	"ssl_session_cache":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE12, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE12, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE12},
+	"ssl_session_ticket_key":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_session_tickets":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_FLAG, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_FLAG, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"ssl_session_timeout":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_stapling":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_FLAG},
+	"ssl_stapling_file":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_stapling_responder":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_stapling_verify":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_FLAG},
+	"ssl_trusted_certificate":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_verify_client":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"ssl_verify_depth":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"starttls":                          {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"stream":                            {ngx_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS},
+	"stub_status":                       {ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS | ngx_CONF_TAKE1},
+	"sub_filter":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"sub_filter_last_modified":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"sub_filter_once":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"sub_filter_types":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"subrequest_output_buffer_size":     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"tcp_nodelay":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG, ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"tcp_nopush":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"thread_pool":                       {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE23},
+	"timeout":                           {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_TAKE1},
+	"timer_resolution":                  {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"try_files":                         {ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_2MORE},
+	"types":                             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_BLOCK | ngx_CONF_NOARGS},
+	"types_hash_bucket_size":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"types_hash_max_size":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"underscores_in_headers":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_CONF_FLAG},
+	"uninitialized_variable_warn":       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_SIF_CONF | ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_FLAG},
+	"upstream":                          {ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE1},
+	"use":                               {ngx_EVENT_CONF | ngx_CONF_TAKE1},
+	"user":                              {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE12},
+	"userid":                            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"userid_domain":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"userid_expires":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"userid_mark":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"userid_name":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"userid_p3p":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"userid_path":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"userid_service":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_bind":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"uwsgi_buffer_size":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_buffering":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_buffers":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"uwsgi_busy_buffers_size":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_cache":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_cache_background_update":     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_cache_bypass":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"uwsgi_cache_key":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_cache_lock":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_cache_lock_age":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_cache_lock_timeout":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_cache_max_range_offset":      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_cache_methods":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"uwsgi_cache_min_uses":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_cache_path":                  {ngx_HTTP_MAIN_CONF | ngx_CONF_2MORE},
+	"uwsgi_cache_revalidate":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_cache_use_stale":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"uwsgi_cache_valid":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"uwsgi_connect_timeout":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_force_ranges":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_hide_header":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_ignore_client_abort":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_ignore_headers":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"uwsgi_intercept_errors":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_limit_rate":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_max_temp_file_size":          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_modifier1":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_modifier2":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_next_upstream":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"uwsgi_next_upstream_timeout":       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_next_upstream_tries":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_no_cache":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"uwsgi_param":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE23},
+	"uwsgi_pass":                        {ngx_HTTP_LOC_CONF | ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"uwsgi_pass_header":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_pass_request_body":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_pass_request_headers":        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_read_timeout":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_request_buffering":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_send_timeout":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_socket_keepalive":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_ssl_certificate":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_ssl_certificate_key":         {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_ssl_ciphers":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_ssl_crl":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_ssl_name":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_ssl_password_file":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_ssl_protocols":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"uwsgi_ssl_server_name":             {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_ssl_session_reuse":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_ssl_trusted_certificate":     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_ssl_verify":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"uwsgi_ssl_verify_depth":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_store":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_store_access":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE123},
+	"uwsgi_temp_file_write_size":        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"uwsgi_temp_path":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1234},
+	"valid_referers":                    {ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"variables_hash_bucket_size":        {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE1},
+	"variables_hash_max_size":           {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE1},
+	"worker_aio_requests":               {ngx_EVENT_CONF | ngx_CONF_TAKE1},
+	"worker_connections":                {ngx_EVENT_CONF | ngx_CONF_TAKE1},
+	"worker_cpu_affinity":               {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_1MORE},
+	"worker_priority":                   {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"worker_processes":                  {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"worker_rlimit_core":                {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"worker_rlimit_nofile":              {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"worker_shutdown_timeout":           {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"working_directory":                 {ngx_MAIN_CONF | ngx_DIRECT_CONF | ngx_CONF_TAKE1},
+	"xclient":                           {ngx_MAIL_MAIN_CONF | ngx_MAIL_SRV_CONF | ngx_CONF_FLAG},
+	"xml_entities":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"xslt_last_modified":                {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"xslt_param":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"xslt_string_param":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"xslt_stylesheet":                   {ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"xslt_types":                        {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"zone":                              {ngx_HTTP_UPS_CONF | ngx_CONF_TAKE12, ngx_STREAM_UPS_CONF | ngx_CONF_TAKE12},
+	"api":                               {ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS | ngx_CONF_TAKE1},
+	"auth_jwt":                          {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"auth_jwt_claim_set":                {ngx_HTTP_MAIN_CONF | ngx_CONF_2MORE},
+	"auth_jwt_header_set":               {ngx_HTTP_MAIN_CONF | ngx_CONF_2MORE},
+	"auth_jwt_key_file":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"auth_jwt_key_request":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"auth_jwt_leeway":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"f4f":                               {ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS},
+	"f4f_buffer_size":                   {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"fastcgi_cache_purge":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"health_check":                      {ngx_HTTP_LOC_CONF | ngx_CONF_ANY, ngx_STREAM_SRV_CONF | ngx_CONF_ANY},
+	"health_check_timeout":              {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"hls":                               {ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS},
+	"hls_buffers":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE2},
+	"hls_forward_args":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"hls_fragment":                      {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"hls_mp4_buffer_size":               {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"hls_mp4_max_buffer_size":           {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"js_access":                         {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"js_content":                        {ngx_HTTP_LOC_CONF | ngx_HTTP_LMT_CONF | ngx_CONF_TAKE1},
+	"js_filter":                         {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"js_include":                        {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE1},
+	"js_path":                           {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE1},
+	"js_preread":                        {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"js_set":                            {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE2, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE2},
+	"keyval":                            {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE3, ngx_STREAM_MAIN_CONF | ngx_CONF_TAKE3},
+	"keyval_zone":                       {ngx_HTTP_MAIN_CONF | ngx_CONF_1MORE, ngx_STREAM_MAIN_CONF | ngx_CONF_1MORE},
+	"least_time":                        {ngx_HTTP_UPS_CONF | ngx_CONF_TAKE12, ngx_STREAM_UPS_CONF | ngx_CONF_TAKE12},
+	"limit_zone":                        {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE3},
+	"match":                             {ngx_HTTP_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE1, ngx_STREAM_MAIN_CONF | ngx_CONF_BLOCK | ngx_CONF_TAKE1},
+	"memcached_force_ranges":            {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_FLAG},
+	"mp4_limit_rate":                    {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"mp4_limit_rate_after":              {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"ntlm":                              {ngx_HTTP_UPS_CONF | ngx_CONF_NOARGS},
+	"proxy_cache_purge":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"queue":                             {ngx_HTTP_UPS_CONF | ngx_CONF_TAKE12},
+	"scgi_cache_purge":                  {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"session_log":                       {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1},
+	"session_log_format":                {ngx_HTTP_MAIN_CONF | ngx_CONF_2MORE},
+	"session_log_zone":                  {ngx_HTTP_MAIN_CONF | ngx_CONF_TAKE23 | ngx_CONF_TAKE4 | ngx_CONF_TAKE5 | ngx_CONF_TAKE6},
+	"state":                             {ngx_HTTP_UPS_CONF | ngx_CONF_TAKE1, ngx_STREAM_UPS_CONF | ngx_CONF_TAKE1},
+	"status":                            {ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS},
+	"status_format":                     {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_TAKE12},
+	"status_zone":                       {ngx_HTTP_SRV_CONF | ngx_CONF_TAKE1, ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1, ngx_HTTP_LOC_CONF | ngx_CONF_TAKE1, ngx_HTTP_LIF_CONF | ngx_CONF_TAKE1},
+	"sticky":                            {ngx_HTTP_UPS_CONF | ngx_CONF_1MORE},
+	"sticky_cookie_insert":              {ngx_HTTP_UPS_CONF | ngx_CONF_TAKE1234},
+	"upstream_conf":                     {ngx_HTTP_LOC_CONF | ngx_CONF_NOARGS},
+	"uwsgi_cache_purge":                 {ngx_HTTP_MAIN_CONF | ngx_HTTP_SRV_CONF | ngx_HTTP_LOC_CONF | ngx_CONF_1MORE},
+	"zone_sync":                         {ngx_STREAM_SRV_CONF | ngx_CONF_NOARGS},
+	"zone_sync_buffers":                 {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE2},
+	"zone_sync_connect_retry_interval":  {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_connect_timeout":         {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_interval":                {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_recv_buffer_size":        {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_server":                  {ngx_STREAM_SRV_CONF | ngx_CONF_TAKE12},
+	"zone_sync_ssl":                     {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"zone_sync_ssl_certificate":         {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_ssl_certificate_key":     {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_ssl_ciphers":             {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_ssl_crl":                 {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_ssl_name":                {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_ssl_password_file":       {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_ssl_protocols":           {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_1MORE},
+	"zone_sync_ssl_server_name":         {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"zone_sync_ssl_trusted_certificate": {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_ssl_verify":              {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_FLAG},
+	"zone_sync_ssl_verify_depth":        {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+	"zone_sync_timeout":                 {ngx_STREAM_MAIN_CONF | ngx_STREAM_SRV_CONF | ngx_CONF_TAKE1},
+}
@@ -0,0 +1,363 @@
+package nginx
+
+import (
+	"fmt"
+	"math"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+// The blocks of the map, geo, split_clients, types and match directives don't contain
+// directives but key/value pairs, which are parsed as directives whose name is the key; the
+// types in this file give a structured view of them. Their constructors report the same
+// errors reported by nginx while loading the configuration.
+
+// Map is the block of a map directive, which sets Variable according to the value of Source.
+type Map struct {
+	Source    string     `json:"source"`
+	Variable  string     `json:"variable"`
+	Hostnames bool       `json:"hostnames,omitempty"` // keys can be host names with a wildcard.
+	Volatile  bool       `json:"volatile,omitempty"`  // the variable is not cacheable.
+	Default   string     `json:"default,omitempty"`
+	Entries   []MapEntry `json:"entries"`
+	Includes  []string   `json:"includes,omitempty"` // the files included by the block.
+}
+
+// MapEntry is a key of a map and its value.
+type MapEntry struct {
+	Key             string `json:"key"` // the key, without the '~' or "~*" of regular expressions.
+	Value           string `json:"value"`
+	Regex           bool   `json:"regex,omitempty"`
+	CaseInsensitive bool   `json:"case_insensitive,omitempty"` // the regular expression starts with "~*".
+}
+
+// NewMap returns the map defined by a map directive.
+func NewMap(d *DirectiveNode) (*Map, error) {
+	args, block, err := typedBlock(d, 2, 2)
+	if err != nil {
+		return nil, err
+	}
+	m := &Map{Source: args[0], Variable: args[1], Entries: []MapEntry{}}
+
+	for _, e := range blockDirectives(block) {
+		key, values := entry(e)
+		switch {
+		case len(values) == 0 && key == "hostnames":
+			m.Hostnames = true
+		case len(values) == 0 && key == "volatile":
+			m.Volatile = true
+		case len(values) != 1:
+			return nil, nodeError(e, "invalid number of the map parameters")
+		case key == "include":
+			m.Includes = append(m.Includes, values[0])
+		case key == "default":
+			m.Default = values[0]
+		case strings.HasPrefix(key, "~"):
+			key = key[1:]
+			insensitive := strings.HasPrefix(key, "*")
+			if insensitive {
+				key = key[1:]
+			}
+			m.Entries = append(m.Entries, MapEntry{Key: key, Value: values[0], Regex: true, CaseInsensitive: insensitive})
+		default:
+			// a backslash escapes a key starting with '~'.
+			m.Entries = append(m.Entries, MapEntry{Key: strings.TrimPrefix(key, `\`), Value: values[0]})
+		}
+	}
+	return m, nil
+}
+
+// Geo is the block of a geo directive, which sets Variable according to the IP address in
+// Source.
+type Geo struct {
+	Source         string     `json:"source"`
+	Variable       string     `json:"variable"`
+	Default        string     `json:"default,omitempty"`
+	Ranges         bool       `json:"ranges,omitempty"` // the keys are ranges of addresses, not networks.
+	Proxies        []string   `json:"proxies,omitempty"`
+	ProxyRecursive bool       `json:"proxy_recursive,omitempty"`
+	Deleted        []string   `json:"deleted,omitempty"` // the networks removed by "delete".
+	Entries        []GeoEntry `json:"entries"`
+	Includes       []string   `json:"includes,omitempty"`
+}
+
+// GeoEntry is a network, or a range of addresses, and its value. Prefix is set for networks,
+// while From and To are set for ranges.
+type GeoEntry struct {
+	Key    string       `json:"key"` // the network or the range, as written.
+	Value  string       `json:"value"`
+	Prefix netip.Prefix `json:"prefix"`
+	From   netip.Addr   `json:"from"`
+	To     netip.Addr   `json:"to"`
+}
+
+// NewGeo returns the geo block defined by a geo directive; when the source is omitted, it's
+// $remote_addr.
+func NewGeo(d *DirectiveNode) (*Geo, error) {
+	args, block, err := typedBlock(d, 1, 2)
+	if err != nil {
+		return nil, err
+	}
+	g := &Geo{Source: "$remote_addr", Variable: args[0], Entries: []GeoEntry{}}
+	if len(args) == 2 {
+		g.Source, g.Variable = args[0], args[1]
+	}
+
+	for _, e := range blockDirectives(block) {
+		key, values := entry(e)
+		switch {
+		case len(values) == 0 && key == "ranges":
+			g.Ranges = true
+		case len(values) == 0 && key == "proxy_recursive":
+			g.ProxyRecursive = true
+		case len(values) != 1:
+			return nil, nodeError(e, "invalid number of the geo parameters")
+		case key == "include":
+			g.Includes = append(g.Includes, values[0])
+		case key == "default":
+			g.Default = values[0]
+		case key == "proxy":
+			if _, err := parseNetwork(values[0]); err != nil {
+				return nil, nodeError(e, "%s", err)
+			}
+			g.Proxies = append(g.Proxies, values[0])
+		case key == "delete":
+			g.Deleted = append(g.Deleted, values[0])
+		default:
+			ge := GeoEntry{Key: key, Value: values[0]}
+			if g.Ranges {
+				if ge.From, ge.To, err = parseRange(key); err != nil {
+					return nil, nodeError(e, "%s", err)
+				}
+			} else if ge.Prefix, err = parseNetwork(key); err != nil {
+				return nil, nodeError(e, "%s", err)
+			}
+			g.Entries = append(g.Entries, ge)
+		}
+	}
+	return g, nil
+}
+
+// parseNetwork parses a network in CIDR notation, or a single address.
+func parseNetwork(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid network %q", s)
+		}
+		return p.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid network %q", s)
+	}
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
+
+// parseRange parses a range of IPv4 addresses, like "10.0.0.1-10.0.0.9".
+func parseRange(s string) (from, to netip.Addr, err error) {
+	first, last, ok := strings.Cut(s, "-")
+	if ok {
+		from, err = netip.ParseAddr(first)
+	}
+	if ok && err == nil {
+		to, err = netip.ParseAddr(last)
+	}
+	if !ok || err != nil || !from.Is4() || !to.Is4() {
+		return from, to, fmt.Errorf("invalid range %q", s)
+	}
+	if to.Less(from) {
+		return from, to, fmt.Errorf("invalid range %q: the first address is greater than the last", s)
+	}
+	return from, to, nil
+}
+
+// SplitClients is the block of a split_clients directive, which sets Variable according to
+// the hash of Source.
+type SplitClients struct {
+	Source   string        `json:"source"`
+	Variable string        `json:"variable"`
+	Buckets  []SplitBucket `json:"buckets"`
+}
+
+// SplitBucket is the value of the variable for a percentage of the clients; the bucket "*"
+// takes the rest of the clients.
+type SplitBucket struct {
+	Percent float64 `json:"percent"`
+	Rest    bool    `json:"rest,omitempty"`
+	Value   string  `json:"value"`
+}
+
+// NewSplitClients returns the split_clients block defined by a split_clients directive; like
+// nginx, it returns an error if the total percentage is greater than 100%.
+func NewSplitClients(d *DirectiveNode) (*SplitClients, error) {
+	args, block, err := typedBlock(d, 2, 2)
+	if err != nil {
+		return nil, err
+	}
+	s := &SplitClients{Source: args[0], Variable: args[1], Buckets: []SplitBucket{}}
+
+	total := 0 // hundredths of percent, the precision used by nginx.
+	for _, e := range blockDirectives(block) {
+		key, values := entry(e)
+		if len(values) != 1 {
+			return nil, nodeError(e, "invalid number of the split_clients parameters")
+		}
+		if key == "*" {
+			s.Buckets = append(s.Buckets, SplitBucket{Percent: float64(10000-total) / 100, Rest: true, Value: values[0]})
+			continue
+		}
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(key, "%"), 64)
+		if err != nil || !strings.HasSuffix(key, "%") || percent <= 0 {
+			return nil, nodeError(e, "invalid percent value %q", key)
+		}
+		total += int(math.Round(percent * 100))
+		if total > 10000 {
+			return nil, nodeError(e, "percent total is greater than 100%%")
+		}
+		s.Buckets = append(s.Buckets, SplitBucket{Percent: percent, Value: values[0]})
+	}
+	return s, nil
+}
+
+// NewTypes returns the MIME types defined by a types directive, with their file extensions.
+func NewTypes(d *DirectiveNode) (map[string][]string, error) {
+	_, block, err := typedBlock(d, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	types := make(map[string][]string)
+	for _, e := range blockDirectives(block) {
+		mime, exts := entry(e)
+		if len(exts) == 0 {
+			return nil, nodeError(e, "invalid number of the types parameters")
+		}
+		types[mime] = append(types[mime], exts...)
+	}
+	return types, nil
+}
+
+// Match is the block of a match directive, which defines the conditions checked by the health
+// checks of an upstream group.
+type Match struct {
+	Name       string           `json:"name"`
+	Conditions []MatchCondition `json:"conditions"`
+}
+
+// MatchCondition is a test of a match block, like "status 200" or "body ~ ok"; Name is one of
+// status, header, body, or send and expect in the stream context.
+type MatchCondition struct {
+	Name string   `json:"name"`
+	Args []string `json:"args"`
+}
+
+// NewMatch returns the conditions defined by a match directive.
+func NewMatch(d *DirectiveNode) (*Match, error) {
+	args, block, err := typedBlock(d, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	m := &Match{Name: args[0], Conditions: []MatchCondition{}}
+	for _, e := range blockDirectives(block) {
+		name, values := entry(e)
+		switch name {
+		case "status", "header", "body", "send", "expect":
+		default:
+			return nil, nodeError(e, "unknown match condition %q", name)
+		}
+		m.Conditions = append(m.Conditions, MatchCondition{Name: name, Args: values})
+	}
+	return m, nil
+}
+
+// checkStructured checks the arguments and the block of the directives with a structured view,
+// if d is one of them: the blocks with key/value pairs, the conditions of if directives and
+// the locations, whose regular expressions are reported with a warning when they can't be
+// converted to RE2.
+func checkStructured(d *DirectiveNode) *ParseError {
+	var err error
+	switch d.Text {
+	case "location":
+		var loc *Location
+		if loc, err = NewLocation(d); err == nil && loc.Warning != "" {
+			w := nodeError(d, "%s", loc.Warning)
+			w.Severity = SeverityWarning
+			return w
+		}
+	case "if":
+		_, err = d.Condition()
+	case "map":
+		_, err = NewMap(d)
+	case "geo":
+		_, err = NewGeo(d)
+	case "split_clients":
+		_, err = NewSplitClients(d)
+	case "types":
+		_, err = NewTypes(d)
+	case "match":
+		_, err = NewMatch(d)
+	}
+	if err != nil {
+		return err.(*ParseError)
+	}
+	return nil
+}
+
+// typedBlock returns the values of the arguments of d and its block, checking that the number
+// of arguments is between minArgs and maxArgs.
+func typedBlock(d *DirectiveNode, minArgs, maxArgs int) ([]string, *BlockNode, error) {
+	var args []string
+	var block *BlockNode
+	for _, a := range d.Args {
+		switch a := a.(type) {
+		case *ArgumentNode:
+			args = append(args, a.Value)
+		case *BlockNode:
+			block = a
+		}
+	}
+	if len(args) < minArgs || len(args) > maxArgs || block == nil {
+		return nil, nil, nodeError(d, "invalid %q directive", d.Text)
+	}
+	return args, block, nil
+}
+
+// blockDirectives returns the directives in a block.
+func blockDirectives(b *BlockNode) []*DirectiveNode {
+	var res []*DirectiveNode
+	for _, n := range b.List.Nodes {
+		if d, ok := n.(*DirectiveNode); ok {
+			res = append(res, d)
+		}
+	}
+	return res
+}
+
+// entry returns the key of an entry of a block, which is the name of the directive, and the
+// values of its arguments.
+func entry(d *DirectiveNode) (string, []string) {
+	key, _ := unquoteArg(d.Text)
+	var values []string
+	for _, a := range d.Args {
+		if a, ok := a.(*ArgumentNode); ok {
+			values = append(values, a.Value)
+		}
+	}
+	return key, values
+}
+
+// nodeError returns an error about the node n.
+func nodeError(n Node, format string, args ...interface{}) *ParseError {
+	start := n.Span().Start
+	return &ParseError{
+		Severity: SeverityError,
+		Kind:     KindArguments,
+		Filename: start.Filename,
+		Line:     start.Line,
+		Column:   start.Column,
+		Offset:   start.Offset,
+		Token:    n.String(),
+		Msg:      fmt.Sprintf(format, args...),
+	}
+}
@@ -0,0 +1,221 @@
+package nginx
+
+import (
+	"errors"
+	"net/netip"
+	"reflect"
+	"testing"
+)
+
+// parseHTTPDirective parses a directive of the http context and returns it.
+func parseHTTPDirective(t *testing.T, text string) *DirectiveNode {
+	t.Helper()
+	tree, err := Parse("test", "http {\n"+text+"\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block(tree.Root, 0).List.Nodes[0].(*DirectiveNode)
+}
+
+func TestNewMap(t *testing.T) {
+	d := parseHTTPDirective(t, `map $http_host $name {
+    hostnames;
+    volatile;
+    default 0;
+    example.com 1;
+    *.example.com 2;
+    "~^www\.(?<n>.+)$" $n;
+    ~*\.ORG$ 3;
+    \~literal 4;
+}`)
+	m, err := NewMap(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Map{
+		Source:    "$http_host",
+		Variable:  "$name",
+		Hostnames: true,
+		Volatile:  true,
+		Default:   "0",
+		Entries: []MapEntry{
+			{Key: "example.com", Value: "1"},
+			{Key: "*.example.com", Value: "2"},
+			{Key: `^www\.(?<n>.+)$`, Value: "$n", Regex: true},
+			{Key: `\.ORG$`, Value: "3", Regex: true, CaseInsensitive: true},
+			{Key: "~literal", Value: "4"},
+		},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("unexpected map:\n%+v", m)
+	}
+}
+
+func TestNewGeo(t *testing.T) {
+	d := parseHTTPDirective(t, `geo $country {
+    default ZZ;
+    proxy 192.168.100.0/24;
+    proxy_recursive;
+    127.0.0.0/24 US;
+    127.0.0.1 RU;
+    10.1.0.0/16 RU;
+    2001:db8::/32 NL;
+    delete 127.0.0.0/16;
+}`)
+	g, err := NewGeo(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Source != "$remote_addr" || g.Variable != "$country" || g.Default != "ZZ" || !g.ProxyRecursive {
+		t.Errorf("unexpected geo: %+v", g)
+	}
+	if !reflect.DeepEqual(g.Proxies, []string{"192.168.100.0/24"}) || !reflect.DeepEqual(g.Deleted, []string{"127.0.0.0/16"}) {
+		t.Errorf("unexpected proxies and deleted networks: %q, %q", g.Proxies, g.Deleted)
+	}
+	prefixes := []string{"127.0.0.0/24", "127.0.0.1/32", "10.1.0.0/16", "2001:db8::/32"}
+	if len(g.Entries) != len(prefixes) {
+		t.Fatalf("unexpected entries: %+v", g.Entries)
+	}
+	for i, e := range g.Entries {
+		if e.Prefix != netip.MustParsePrefix(prefixes[i]) {
+			t.Errorf("entry %d: expected %s, got %s", i, prefixes[i], e.Prefix)
+		}
+	}
+
+	d = parseHTTPDirective(t, `geo $arg_ip $range {
+    ranges;
+    10.0.0.0-10.0.0.255 a;
+}`)
+	if g, err = NewGeo(d); err != nil {
+		t.Fatal(err)
+	}
+	e := g.Entries[0]
+	if g.Source != "$arg_ip" || !g.Ranges || e.From != netip.MustParseAddr("10.0.0.0") || e.To != netip.MustParseAddr("10.0.0.255") {
+		t.Errorf("unexpected geo: %+v", g)
+	}
+
+	// the parser reports the same errors.
+	_, err = Parse("test", "http {\n    geo $bad {\n        10.0.0.300/8 x;\n    }\n}\n")
+	if err == nil || err.Error() != `test:3:9: invalid network "10.0.0.300/8"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNewSplitClients(t *testing.T) {
+	d := parseHTTPDirective(t, `split_clients "${remote_addr}AAA" $variant {
+    0.5% .one;
+    2.0% .two;
+    * "";
+}`)
+	s, err := NewSplitClients(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &SplitClients{
+		Source:   "${remote_addr}AAA",
+		Variable: "$variant",
+		Buckets: []SplitBucket{
+			{Percent: 0.5, Value: ".one"},
+			{Percent: 2, Value: ".two"},
+			{Percent: 97.5, Rest: true, Value: ""},
+		},
+	}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("unexpected split_clients:\n%+v", s)
+	}
+}
+
+func TestSplitClientsTotal(t *testing.T) {
+	text := "http {\n    split_clients $a $b {\n        60% a;\n        40.01% b;\n    }\n}\n"
+	_, err := Parse("test", text)
+	var perr *ParseError
+	if !errors.As(err, &perr) || perr.Error() != "test:4:9: percent total is greater than 100%" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tree := New("test")
+	tree.Mode = AllErrors
+	tree, _ = tree.Parse(text)
+	if _, err := NewConfiguration(tree); err == nil {
+		t.Error("expected an error from NewConfiguration")
+	}
+}
+
+func TestNewTypes(t *testing.T) {
+	d := parseHTTPDirective(t, `types {
+    text/html html htm shtml;
+    image/jpeg jpeg;
+    image/jpeg jpg;
+}`)
+	types, err := NewTypes(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string][]string{
+		"text/html":  {"html", "htm", "shtml"},
+		"image/jpeg": {"jpeg", "jpg"},
+	}
+	if !reflect.DeepEqual(types, expected) {
+		t.Errorf("unexpected types: %q", types)
+	}
+}
+
+func TestNewMatch(t *testing.T) {
+	d := parseHTTPDirective(t, `match welcome {
+    status 200;
+    header Content-Type = text/html;
+    body ~ "Welcome to nginx!";
+}`)
+	m, err := NewMatch(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Match{
+		Name: "welcome",
+		Conditions: []MatchCondition{
+			{Name: "status", Args: []string{"200"}},
+			{Name: "header", Args: []string{"Content-Type", "=", "text/html"}},
+			{Name: "body", Args: []string{"~", "Welcome to nginx!"}},
+		},
+	}
+	if !reflect.DeepEqual(m, expected) {
+		t.Errorf("unexpected match:\n%+v", m)
+	}
+}
+
+func TestConfigurationBlocks(t *testing.T) {
+	tree, err := Parse("test", "http {\n    map $uri $m {\n        default 1;\n    }\n    types {\n        text/plain txt;\n    }\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := NewConfiguration(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	http := cfg.Directives[0]
+	if m := http.Block[0].Map; m == nil || m.Default != "1" {
+		t.Errorf("unexpected map: %+v", m)
+	}
+	if types := http.Block[1].Types; !reflect.DeepEqual(types, map[string][]string{"text/plain": {"txt"}}) {
+		t.Errorf("unexpected types: %q", types)
+	}
+}
+
+func TestConfigurationMapEntries(t *testing.T) {
+	// the keys of a map can be the names of directives with a block, like match.
+	tree, err := Parse("test", "http {\n    map $arg_action $h {\n        default none;\n        match search;\n        types mime;\n    }\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := NewConfiguration(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := cfg.Directives[0].Block[0].Map
+	if m == nil || len(m.Entries) != 2 || m.Entries[0].Key != "match" || m.Entries[1].Key != "types" {
+		t.Fatalf("unexpected map: %+v", m)
+	}
+	if entry := cfg.Directives[0].Block[0].Block[1]; entry.Match != nil || entry.Types != nil {
+		t.Errorf("a map entry was decoded as a directive: %+v", entry)
+	}
+}
@@ -0,0 +1,174 @@
+package nginx
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Expr is the condition of an if directive; it's one of *VarExpr, *CompareExpr, *RegexExpr and
+// *FileExpr. The strings in an expression are the values of the arguments, without quotes, and
+// can contain variables, except for the variables on the left side of the comparisons.
+type Expr interface {
+	// String returns the condition as it's written in the configuration, without parentheses.
+	String() string
+	expr()
+}
+
+// VarExpr is true when the value of the variable is neither empty nor "0".
+type VarExpr struct {
+	Variable string // the name of the variable, including the '$'.
+}
+
+// CompareExpr compares the value of a variable with a string, with the operator "=", or "!="
+// when Negated is true.
+type CompareExpr struct {
+	Variable string
+	Negated  bool
+	Value    string
+}
+
+// RegexExpr matches the value of a variable against a regular expression, with the operators
+// "~", "~*" (CaseInsensitive), "!~" and "!~*" (Negated).
+type RegexExpr struct {
+	Variable        string
+	Negated         bool
+	CaseInsensitive bool
+	Regex           string
+}
+
+// FileTest is the test of a file in a FileExpr.
+type FileTest byte
+
+const (
+	FileIsFile       FileTest = 'f' // "-f": the file exists and it's not a directory.
+	FileIsDir        FileTest = 'd' // "-d": the directory exists.
+	FileExists       FileTest = 'e' // "-e": the file, directory or symbolic link exists.
+	FileIsExecutable FileTest = 'x' // "-x": the file is executable.
+)
+
+// FileExpr tests a file, with the operators "-f", "-d", "-e" and "-x", or their negated
+// forms "!-f", "!-d", "!-e" and "!-x".
+type FileExpr struct {
+	Test    FileTest
+	Negated bool
+	Path    string
+}
+
+func (e *VarExpr) String() string {
+	return e.Variable
+}
+
+func (e *CompareExpr) String() string {
+	op := "="
+	if e.Negated {
+		op = "!="
+	}
+	return e.Variable + " " + op + " " + quoteArg(e.Value, QuoteNone)
+}
+
+func (e *RegexExpr) String() string {
+	op := "~"
+	if e.Negated {
+		op = "!~"
+	}
+	if e.CaseInsensitive {
+		op += "*"
+	}
+	return e.Variable + " " + op + " " + quoteArg(e.Regex, QuoteNone)
+}
+
+func (e *FileExpr) String() string {
+	op := "-" + string(e.Test)
+	if e.Negated {
+		op = "!" + op
+	}
+	return op + " " + quoteArg(e.Path, QuoteNone)
+}
+
+func (e *VarExpr) expr()     {}
+func (e *CompareExpr) expr() {}
+func (e *RegexExpr) expr()   {}
+func (e *FileExpr) expr()    {}
+
+// ParseCondition parses the condition of an if directive, given the values of its arguments,
+// which include the parentheses, as in ngx_http_rewrite_if_condition().
+func ParseCondition(args []string) (Expr, error) {
+	if len(args) == 0 {
+		return nil, errors.New("missing condition")
+	}
+	args = append([]string(nil), args...)
+
+	// the parentheses can be separate arguments or part of the first and last argument.
+	first, last := args[0], args[len(args)-1]
+	if !strings.HasPrefix(first, "(") {
+		return nil, fmt.Errorf("invalid condition %q", first)
+	}
+	if !strings.HasSuffix(last, ")") || len(args) == 1 && len(first) == 1 {
+		return nil, fmt.Errorf("invalid condition %q", last)
+	}
+	args[len(args)-1] = last[:len(last)-1]
+	args[0] = args[0][1:]
+	if args[len(args)-1] == "" {
+		args = args[:len(args)-1]
+	}
+	if len(args) > 0 && args[0] == "" {
+		args = args[1:]
+	}
+
+	if len(args) > 0 && len(args[0]) > 1 && args[0][0] == '$' {
+		variable := args[0]
+		switch {
+		case len(args) == 1:
+			return &VarExpr{Variable: variable}, nil
+		case len(args) != 3:
+			return nil, fmt.Errorf("unexpected %q in condition", args[len(args)-1])
+		}
+		switch op := args[1]; op {
+		case "=", "!=":
+			return &CompareExpr{Variable: variable, Negated: op == "!=", Value: args[2]}, nil
+		case "~", "~*", "!~", "!~*":
+			return &RegexExpr{
+				Variable:        variable,
+				Negated:         op[0] == '!',
+				CaseInsensitive: op[len(op)-1] == '*',
+				Regex:           args[2],
+			}, nil
+		default:
+			return nil, fmt.Errorf("unexpected %q in condition", op)
+		}
+	}
+
+	if len(args) == 2 {
+		op := args[0]
+		negated := strings.HasPrefix(op, "!")
+		switch strings.TrimPrefix(op, "!") {
+		case "-f", "-d", "-e", "-x":
+			return &FileExpr{Test: FileTest(op[len(op)-1]), Negated: negated, Path: args[1]}, nil
+		}
+		return nil, fmt.Errorf("unexpected %q in condition", op)
+	}
+
+	if len(args) == 0 {
+		return nil, errors.New("invalid condition \"()\"")
+	}
+	return nil, fmt.Errorf("invalid condition %q", args[0])
+}
+
+// Condition returns the condition of an if directive.
+func (d *DirectiveNode) Condition() (Expr, error) {
+	if d.Text != "if" {
+		return nil, nodeError(d, "%q is not an if directive", d.Text)
+	}
+	var args []string
+	for _, a := range d.Args {
+		if a, ok := a.(*ArgumentNode); ok {
+			args = append(args, a.Value)
+		}
+	}
+	expr, err := ParseCondition(args)
+	if err != nil {
+		return nil, nodeError(d, "%s", err)
+	}
+	return expr, nil
+}
@@ -0,0 +1,81 @@
+package nginx
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCondition(t *testing.T) {
+	var tests = []struct {
+		input    string
+		expected Expr
+		err      string
+	}{
+		{`if ($slow) {}`, &VarExpr{Variable: "$slow"}, ""},
+		{`if ( $slow ) {}`, &VarExpr{Variable: "$slow"}, ""},
+		{`if ($request_method = POST) {}`, &CompareExpr{Variable: "$request_method", Value: "POST"}, ""},
+		{`if ($http_host != "example.com") {}`, &CompareExpr{Variable: "$http_host", Negated: true, Value: "example.com"}, ""},
+		{`if ($http_user_agent ~ MSIE) {}`, &RegexExpr{Variable: "$http_user_agent", Regex: "MSIE"}, ""},
+		{`if ($http_cookie ~* "id=([^;]+)(?:;|$)") {}`, &RegexExpr{Variable: "$http_cookie", CaseInsensitive: true, Regex: "id=([^;]+)(?:;|$)"}, ""},
+		{`if ($uri !~ ^/(a|b)) {}`, &RegexExpr{Variable: "$uri", Negated: true, Regex: "^/(a|b)"}, ""},
+		{`if ($uri !~* \.PHP$ ) {}`, &RegexExpr{Variable: "$uri", Negated: true, CaseInsensitive: true, Regex: `\.PHP$`}, ""},
+		{`if (-f $request_filename) {}`, &FileExpr{Test: FileIsFile, Path: "$request_filename"}, ""},
+		{`if (!-d $document_root/dir) {}`, &FileExpr{Test: FileIsDir, Negated: true, Path: "$document_root/dir"}, ""},
+		{`if (-e /srv/maintenance) {}`, &FileExpr{Test: FileExists, Path: "/srv/maintenance"}, ""},
+		{`if (!-x $cgi) {}`, &FileExpr{Test: FileIsExecutable, Negated: true, Path: "$cgi"}, ""},
+		// errors
+		{`if $slow {}`, nil, `test:2:1: invalid condition "$slow"`},
+		{`if ($slow {}`, nil, `test:2:1: invalid condition "($slow"`},
+		{`if () {}`, nil, `test:2:1: invalid condition "()"`},
+		{`if (slow) {}`, nil, `test:2:1: invalid condition "slow"`},
+		{`if ($a == b) {}`, nil, `test:2:1: unexpected "==" in condition`},
+		{`if ($a = b c) {}`, nil, `test:2:1: unexpected "c" in condition`},
+		{`if (-z $a) {}`, nil, `test:2:1: unexpected "-z" in condition`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			tree := New("test")
+			tree.Mode = AllErrors | SkipContextCheck
+			tree, err := tree.Parse("location / {\n" + tt.input + "\n}")
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			d := block(tree.Root, 0).List.Nodes[0].(*DirectiveNode)
+			expr, err := d.Condition()
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(expr, tt.expected) {
+				t.Fatalf("expected %#v, got %#v", tt.expected, expr)
+			}
+		})
+	}
+}
+
+func TestConditionString(t *testing.T) {
+	var tests = []struct {
+		expr     Expr
+		expected string
+	}{
+		{&VarExpr{Variable: "$a"}, "$a"},
+		{&CompareExpr{Variable: "$a", Negated: true, Value: "b c"}, `$a != "b c"`},
+		{&RegexExpr{Variable: "$a", Negated: true, CaseInsensitive: true, Regex: "^/x{2}$"}, `$a !~* "^/x{2}$"`},
+		{&FileExpr{Test: FileExists, Path: "/srv/a"}, "-e /srv/a"},
+	}
+	for _, tt := range tests {
+		if got := tt.expr.String(); got != tt.expected {
+			t.Errorf("expected %s, got %s", tt.expected, got)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package nginx
+
+import (
+	"fmt"
+	"strings"
+)
+
+//go:generate go run gen_analyser.go
+//go:generate go fmt bitmasks.go
+
+const (
+	itemError itemType = iota // error occurred; value is text of error.
+	itemEOF
+	itemComment    // a comment, which can occupy the whole line or be inline.
+	itemString     // quoted string (including quotes)
+	itemWord       // a generic word, which can be a directive or an argument for a directive
+	itemNewline    // a newline token
+	itemTerminator // the character ';' which terminates a directive
+	itemLeftBlock  // left block delimiter
+	itemRightBlock // right block delimiter
+	itemLua        // the raw Lua code between the brackets of a *_by_lua_block directive
+)
+
+// itemName maps item types to names that can be prettyprinted.
+var itemName = map[itemType]string{
+	itemError:      "error",
+	itemEOF:        "EOF",
+	itemComment:    "comment",
+	itemString:     "quoted string",
+	itemWord:       "word",
+	itemNewline:    "newline",
+	itemTerminator: "terminator",
+	itemLeftBlock:  "open block",
+	itemRightBlock: "close block",
+	itemLua:        "Lua code",
+}
+
+func (i itemType) String() string {
+	s := itemName[i]
+	if s == "" {
+		return fmt.Sprintf("item%d", int(i))
+	}
+	return s
+}
+
+const eof = -1
+
+// bom is the byte order mark, which is skipped at the start of the input.
+const bom = '\uFEFF'
+
+type confContext int
+
+var contextNames = map[int]string{
+	ngx_MAIN_CONF:        "NGX_MAIN_CONF",
+	ngx_EVENT_CONF:       "NGX_EVENT_CONF",
+	ngx_MAIL_MAIN_CONF:   "NGX_MAIL_MAIN_CONF",
+	ngx_MAIL_SRV_CONF:    "NGX_MAIL_SRV_CONF",
+	ngx_STREAM_MAIN_CONF: "NGX_STREAM_MAIN_CONF",
+	ngx_STREAM_SRV_CONF:  "NGX_STREAM_SRV_CONF",
+	ngx_STREAM_UPS_CONF:  "NGX_STREAM_UPS_CONF",
+	ngx_HTTP_MAIN_CONF:   "NGX_HTTP_MAIN_CONF",
+	ngx_HTTP_SRV_CONF:    "NGX_HTTP_SRV_CONF",
+	ngx_HTTP_LOC_CONF:    "NGX_HTTP_LOC_CONF",
+	ngx_HTTP_UPS_CONF:    "NGX_HTTP_UPS_CONF",
+	ngx_HTTP_SIF_CONF:    "NGX_HTTP_SIF_CONF",
+	ngx_HTTP_LIF_CONF:    "NGX_HTTP_LIF_CONF",
+	ngx_HTTP_LMT_CONF:    "NGX_HTTP_LMT_CONF",
+}
+
+// argumentNumber maps a number of arguments to the bit that accepts it, like in ngx_conf_file.c.
+var argumentNumber = []int{
+	ngx_CONF_NOARGS,
+	ngx_CONF_TAKE1,
+	ngx_CONF_TAKE2,
+	ngx_CONF_TAKE3,
+	ngx_CONF_TAKE4,
+	ngx_CONF_TAKE5,
+	ngx_CONF_TAKE6,
+	ngx_CONF_TAKE7,
+}
+
+// argMasks lists the bits of a mask that describe the arguments of a directive.
+var argMasks = []struct {
+	mask int
+	name string
+}{
+	{ngx_CONF_NOARGS, "NGX_CONF_NOARGS"},
+	{ngx_CONF_TAKE1, "NGX_CONF_TAKE1"},
+	{ngx_CONF_TAKE2, "NGX_CONF_TAKE2"},
+	{ngx_CONF_TAKE3, "NGX_CONF_TAKE3"},
+	{ngx_CONF_TAKE4, "NGX_CONF_TAKE4"},
+	{ngx_CONF_TAKE5, "NGX_CONF_TAKE5"},
+	{ngx_CONF_TAKE6, "NGX_CONF_TAKE6"},
+	{ngx_CONF_TAKE7, "NGX_CONF_TAKE7"},
+	{ngx_CONF_BLOCK, "NGX_CONF_BLOCK"},
+	{ngx_CONF_FLAG, "NGX_CONF_FLAG"},
+	{ngx_CONF_ANY, "NGX_CONF_ANY"},
+	{ngx_CONF_1MORE, "NGX_CONF_1MORE"},
+	{ngx_CONF_2MORE, "NGX_CONF_2MORE"},
+}
+
+// argMaskName returns the names of the argument bits set in mask, e.g. "NGX_CONF_TAKE1|NGX_CONF_TAKE2".
+func argMaskName(mask int) string {
+	var names []string
+	for _, m := range argMasks {
+		if mask&m.mask != 0 {
+			names = append(names, m.name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// confContextName returns the name used in the nginx sources for the context c.
+func confContextName(c int) string {
+	if name, ok := contextNames[c]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// context is a stack that keeps track of the current context; it is used by the parser
+// while navigating the tree (the configuration file). Each time the parser steps into a
+// directive whose name appears in `ctxLevels`, it must call Push().
+type context map[string]int
+
+var ctxLevels = []string{"root", "events", "mail", "server", "stream", "upstream", "http", "location", "if", "limit_except"}
+
+func newCtx() *context {
+	c := make(context)
+	for _, lvl := range ctxLevels {
+		c[lvl] = 0
+	}
+	return &c
+}
+
+func (c context) IsContext(dirname string) bool {
+	_, ok := c[dirname]
+	return ok
+}
+
+// Push should be called before parsing a directive's block. Levels are counted, so that
+// nested blocks of the same kind (e.g. a location inside a location) are tracked correctly.
+func (c context) Push(level string) {
+	if _, ok := c[level]; !ok {
+		panic(fmt.Sprintf("unknown context level %q", level))
+	}
+	c[level]++
+}
+
+// Pop should be called after parsing a directive's block.
+func (c context) Pop(level string) {
+	if _, ok := c[level]; !ok {
+		panic(fmt.Sprintf("unknown context level %q", level))
+	}
+	c[level]--
+}
+
+// in reports whether the parser is inside at least one block of the given level.
+func (c context) in(level string) bool {
+	return c[level] > 0
+}
+
+// curContext return the current context; to determine the current context we check which contexts
+// have been "activated" in the stack.
+func (c context) curContext() int {
+	switch {
+	case c.in("events"):
+		return ngx_EVENT_CONF
+	case c.in("mail") && c.in("server"):
+		return ngx_MAIL_SRV_CONF
+	case c.in("mail"):
+		return ngx_MAIL_MAIN_CONF
+	case c.in("stream") && c.in("upstream"):
+		return ngx_STREAM_UPS_CONF
+	case c.in("stream") && c.in("server"):
+		return ngx_STREAM_SRV_CONF
+	case c.in("stream"):
+		return ngx_STREAM_MAIN_CONF
+	case c.in("http") && c.in("location") && c.in("limit_except"):
+		return ngx_HTTP_LMT_CONF
+	case c.in("http") && c.in("location") && c.in("if"):
+		return ngx_HTTP_LIF_CONF
+	case c.in("http") && c.in("server") && c.in("if"):
+		return ngx_HTTP_SIF_CONF
+	case c.in("http") && c.in("upstream"):
+		return ngx_HTTP_UPS_CONF
+	case c.in("http") && c.in("location"):
+		return ngx_HTTP_LOC_CONF
+	case c.in("http") && c.in("server"):
+		return ngx_HTTP_SRV_CONF
+	case c.in("http"):
+		return ngx_HTTP_MAIN_CONF
+	case c.in("root"):
+		return ngx_MAIN_CONF
+	}
+	panic("no context")
+}
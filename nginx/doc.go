@@ -0,0 +1,38 @@
+/*
+Package nginx parses NGINX configuration files.
+
+Parse turns the text of a configuration file into a Tree, a representation that keeps
+comments and empty lines so that a file can be inspected or rewritten:
+
+	tree, err := nginx.Parse("nginx.conf", text)
+
+ParseReader reads the configuration from an io.Reader instead, parsing it while it's read.
+
+Tree.WriteTo writes the tree back: the output of an unmodified tree is identical to the
+parsed text, and after editing the nodes only the changed parts are rendered again.
+An ArgumentNode has both the raw text of an argument, returned by Raw, and its decoded
+Value: Value is the field to set to change an argument, and it's quoted again as needed.
+ArgumentNode.Parts splits the value into text and references to variables, like $host
+or ${uri}, and to regex captures, like $1. DirectiveNode.Condition parses the condition
+of an if directive into an Expr, and NewLocation returns the modifier and the pattern of a
+location, with its regular expression converted to the RE2 syntax of the regexp package.
+FindRoute uses them to choose the server and the location handling a request, like nginx,
+while NewVirtualHosts builds the table of the servers listening on each address and port,
+to resolve a request by socket and Host header and to report conflicting server names.
+Effective computes the directives that apply to a server or a location after nginx's
+inheritance from the outer blocks, each with the directive node it comes from.
+Format, instead, writes the tree in a uniform style controlled by Options.
+
+NewConfiguration walks a Tree and returns a Configuration, which is a simpler view
+made only of directives, the decoded values of their arguments and their blocks; it is the representation
+to use when analysing a configuration, and it can be encoded to JSON. The key/value blocks
+of map, geo, split_clients, types and match directives are also decoded by NewMap, NewGeo,
+NewSplitClients, NewTypes and NewMatch. Each Directive keeps the DirectiveNode it comes
+from, and the model package builds on them a typed view of the http, stream, mail and
+events blocks, with their servers, locations and upstreams.
+
+Unpack splits the output of "nginx -T", which contains the concatenation of all the
+configuration files loaded by nginx, into its single files; UnpackReader does the same
+with an io.Reader.
+*/
+package nginx
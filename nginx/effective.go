@@ -0,0 +1,191 @@
+package nginx
+
+import "fmt"
+
+// Setting is a directive in an EffectiveConfig, with the directive node that sets it.
+type Setting struct {
+	Name      string
+	Args      []string       // the decoded values of the arguments.
+	Directive *DirectiveNode // the directive, whose position is the file and line the value comes from.
+	Inherited bool           // the directive is in an outer block.
+}
+
+// Position returns the file, the line and the column of the directive.
+func (s *Setting) Position() Position {
+	return s.Directive.Span().Start
+}
+
+// EffectiveConfig is the configuration that applies to a block, like a server or a location,
+// after the directives of the outer blocks have been inherited.
+type EffectiveConfig struct {
+	Block    *DirectiveNode // the block, like a server or a location directive.
+	Context  string         // the context of the block, like "NGX_HTTP_LOC_CONF".
+	Settings []*Setting     // the outer settings first, each level in the order of the configuration.
+}
+
+// Get returns the settings of the named directive, if any.
+func (e *EffectiveConfig) Get(name string) []*Setting {
+	var res []*Setting
+	for _, s := range e.Settings {
+		if s.Name == name {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// notInherited are the directives of the rewrite module, which are executed where they appear
+// instead of being inherited.
+var notInherited = map[string]bool{
+	"break":   true,
+	"if":      true,
+	"return":  true,
+	"rewrite": true,
+	"set":     true,
+}
+
+// handlers are the directives setting the content handler of a location, which nginx copies
+// into the if and limit_except blocks of the location, but not into the nested locations.
+var handlers = map[string]bool{
+	"fastcgi_pass":   true,
+	"grpc_pass":      true,
+	"memcached_pass": true,
+	"proxy_pass":     true,
+	"scgi_pass":      true,
+	"uwsgi_pass":     true,
+}
+
+// sameField maps the directives that set the same field of the configuration of a module to a
+// common name: a block setting one of them doesn't inherit the others, like a location with an
+// alias, which doesn't inherit the root of its server.
+var sameField = map[string]string{
+	"alias": "root",
+}
+
+// field returns the name of the configuration field set by the named directive.
+func field(name string) string {
+	if f, ok := sameField[name]; ok {
+		return f
+	}
+	return name
+}
+
+// Effective returns the effective configuration of block, which must be a server, location, if
+// or limit_except block of the http context, or any other block of tree, like the server blocks
+// of the stream and mail contexts.
+//
+// Like nginx, a block inherits a directive from the outer blocks only when it doesn't set the
+// directive itself: an add_header in a location discards all the add_header directives of the
+// server and of the http block, rather than adding to them; the same happens between the
+// directives that set the same value, like root and alias. The directives that can't be set in
+// the context of the block, according to dirMask, are not inherited, like listen in a location;
+// the if and limit_except blocks are nested locations for nginx, so they inherit what can be set
+// in a location, including the content handler, like proxy_pass, which a nested location doesn't
+// inherit. Neither the directives of the rewrite module, like return, nor the directives with a
+// block, like map, are inherited. The inheritance starts from the http, stream, mail,
+// events or upstream block containing block.
+func Effective(tree *Tree, block *DirectiveNode) (*EffectiveConfig, error) {
+	path := blockPath(tree.Root.Nodes, block)
+	if path == nil {
+		return nil, fmt.Errorf("%q directive not found in %s", block.Text, tree.Filename)
+	}
+	ctx := newCtx()
+	ctx.Push("root")
+	if !ctx.IsContext(block.Text) || !hasBlock(block) {
+		return nil, nodeError(block, "%q is not a configuration block", block.Text)
+	}
+
+	e := &EffectiveConfig{Block: block}
+	for _, d := range path {
+		if !ctx.IsContext(d.Text) {
+			continue
+		}
+		ctx.Push(d.Text)
+		mask := ctx.curContext()
+		e.Context = contextNames[mask]
+		switch d.Text {
+		case "http", "stream", "mail", "events", "upstream":
+			e.Settings = nil
+		}
+
+		// the if and limit_except blocks are locations, which inherit the whole configuration
+		// of the location around them.
+		nested := mask&(ngx_HTTP_SIF_CONF|ngx_HTTP_LIF_CONF|ngx_HTTP_LMT_CONF) != 0
+		if nested {
+			mask = ngx_HTTP_LOC_CONF
+		}
+		var inherited []*Setting
+		for _, s := range e.Settings {
+			if !notInherited[s.Name] && (nested || !handlers[s.Name]) && allowedIn(s.Name, mask) {
+				s.Inherited = true
+				inherited = append(inherited, s)
+			}
+		}
+
+		var own []*Setting
+		set := make(map[string]bool)
+		for _, n := range directiveBlock(d) {
+			if hasBlock(n) {
+				continue
+			}
+			name, args := entry(n)
+			own = append(own, &Setting{Name: name, Args: args, Directive: n})
+			set[field(name)] = true
+		}
+
+		e.Settings = e.Settings[:0]
+		for _, s := range inherited {
+			if !set[field(s.Name)] {
+				e.Settings = append(e.Settings, s)
+			}
+		}
+		e.Settings = append(e.Settings, own...)
+	}
+	return e, nil
+}
+
+// allowedIn reports whether the named directive can be set in the context ctx; the unknown
+// directives are allowed everywhere.
+func allowedIn(name string, ctx int) bool {
+	masks, ok := dirMask[name]
+	if !ok {
+		return true
+	}
+	for _, mask := range masks {
+		if mask&ctx != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasBlock reports whether d has a block, not counting Lua code.
+func hasBlock(d *DirectiveNode) bool {
+	if len(d.Args) == 0 {
+		return false
+	}
+	_, ok := d.Args[len(d.Args)-1].(*BlockNode)
+	return ok
+}
+
+// blockPath returns the directives containing target, from the outermost one to target
+// itself, or nil if target is not among nodes.
+func blockPath(nodes []Node, target *DirectiveNode) []*DirectiveNode {
+	for _, n := range nodes {
+		d, ok := n.(*DirectiveNode)
+		if !ok {
+			continue
+		}
+		if d == target {
+			return []*DirectiveNode{d}
+		}
+		if !hasBlock(d) {
+			continue
+		}
+		b := d.Args[len(d.Args)-1].(*BlockNode)
+		if path := blockPath(b.List.Nodes, target); path != nil {
+			return append([]*DirectiveNode{d}, path...)
+		}
+	}
+	return nil
+}
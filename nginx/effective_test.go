@@ -0,0 +1,266 @@
+package nginx
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const effectiveConfig = `worker_processes 4;
+http {
+    root /srv/www;
+    add_header X-Frame-Options DENY;
+    add_header X-Content-Type-Options nosniff;
+    access_log /var/log/nginx/access.log;
+    map $uri $new {
+        default 0;
+    }
+    server {
+        listen 80;
+        server_name example.com;
+        set $backend app;
+        proxy_set_header Host $host;
+        location / {
+            add_header Cache-Control no-store;
+            location /static/ {
+                root /srv/static;
+                if ($arg_debug) {
+                    return 404;
+                }
+            }
+        }
+        location /api/ {
+            access_log off;
+            limit_except GET {
+                deny all;
+            }
+        }
+    }
+}
+stream {
+    proxy_timeout 10s;
+    server {
+        listen 53 udp;
+    }
+}
+`
+
+// settings returns the settings of e as "name args (line)" strings, with a "*" for the
+// inherited ones.
+func settings(e *EffectiveConfig) []string {
+	var res []string
+	for _, s := range e.Settings {
+		str := s.Name + " " + strings.Join(s.Args, " ")
+		if s.Inherited {
+			str = "*" + str
+		}
+		res = append(res, str+" ("+s.Position().String()+")")
+	}
+	return res
+}
+
+func TestEffective(t *testing.T) {
+	tree, err := Parse("test", effectiveConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	http := block(tree.Root, 1).List.Nodes
+	server := directiveBlock(http[5].(*DirectiveNode))
+	root := server[4]
+	static := directiveBlock(root)[1]
+	api := server[5]
+	stream := directiveBlock(tree.Root.Nodes[2].(*DirectiveNode))
+
+	var tests = []struct {
+		name    string
+		block   *DirectiveNode
+		context string
+		want    []string
+	}{
+		{"server", http[5].(*DirectiveNode), "NGX_HTTP_SRV_CONF", []string{
+			"*root /srv/www (test:3:5)",
+			"*add_header X-Frame-Options DENY (test:4:5)",
+			"*add_header X-Content-Type-Options nosniff (test:5:5)",
+			"*access_log /var/log/nginx/access.log (test:6:5)",
+			"listen 80 (test:11:9)",
+			"server_name example.com (test:12:9)",
+			"set $backend app (test:13:9)",
+			"proxy_set_header Host $host (test:14:9)",
+		}},
+		{"location", root, "NGX_HTTP_LOC_CONF", []string{
+			"*root /srv/www (test:3:5)",
+			"*access_log /var/log/nginx/access.log (test:6:5)",
+			"*proxy_set_header Host $host (test:14:9)",
+			"add_header Cache-Control no-store (test:16:13)",
+		}},
+		{"nested location", static, "NGX_HTTP_LOC_CONF", []string{
+			"*access_log /var/log/nginx/access.log (test:6:5)",
+			"*proxy_set_header Host $host (test:14:9)",
+			"*add_header Cache-Control no-store (test:16:13)",
+			"root /srv/static (test:18:17)",
+		}},
+		{"if", directiveBlock(static)[1], "NGX_HTTP_LIF_CONF", []string{
+			"*access_log /var/log/nginx/access.log (test:6:5)",
+			"*proxy_set_header Host $host (test:14:9)",
+			"*add_header Cache-Control no-store (test:16:13)",
+			"*root /srv/static (test:18:17)",
+			"return 404 (test:20:21)",
+		}},
+		{"limit_except", directiveBlock(api)[1], "NGX_HTTP_LMT_CONF", []string{
+			"*root /srv/www (test:3:5)",
+			"*add_header X-Frame-Options DENY (test:4:5)",
+			"*add_header X-Content-Type-Options nosniff (test:5:5)",
+			"*proxy_set_header Host $host (test:14:9)",
+			"*access_log off (test:25:13)",
+			"deny all (test:27:17)",
+		}},
+		{"stream server", stream[1], "NGX_STREAM_SRV_CONF", []string{
+			"*proxy_timeout 10s (test:33:5)",
+			"listen 53 udp (test:35:9)",
+		}},
+	}
+	for _, test := range tests {
+		e, err := Effective(tree, test.block)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if e.Context != test.context {
+			t.Errorf("%s: got context %s, want %s", test.name, e.Context, test.context)
+		}
+		if got := settings(e); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got settings\n%s\nwant\n%s", test.name, strings.Join(got, "\n"), strings.Join(test.want, "\n"))
+		}
+	}
+}
+
+func TestEffectiveGet(t *testing.T) {
+	tree, err := Parse("test", effectiveConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := directiveBlock(block(tree.Root, 1).List.Nodes[5].(*DirectiveNode))
+	e, err := Effective(tree, server[4])
+	if err != nil {
+		t.Fatal(err)
+	}
+	headers := e.Get("add_header")
+	if len(headers) != 1 || headers[0].Args[0] != "Cache-Control" || headers[0].Inherited {
+		t.Errorf("unexpected add_header settings: %v", headers)
+	}
+	if s := e.Get("root"); len(s) != 1 || s[0].Position().Line != 3 || !s[0].Inherited {
+		t.Errorf("unexpected root settings: %v", s)
+	}
+	if s := e.Get("listen"); s != nil {
+		t.Errorf("listen should not be inherited by a location")
+	}
+}
+
+func TestEffectiveErrors(t *testing.T) {
+	tree, err := Parse("test", effectiveConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := Parse("other", "http {\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	http := block(tree.Root, 1).List.Nodes
+
+	var tests = []struct {
+		block *DirectiveNode
+		err   string
+	}{
+		{http[0].(*DirectiveNode), `test:3:5: "root" is not a configuration block`},
+		{http[4].(*DirectiveNode), `test:7:5: "map" is not a configuration block`},
+		{other.Root.Nodes[0].(*DirectiveNode), `"http" directive not found in test`},
+	}
+	for _, test := range tests {
+		_, err := Effective(tree, test.block)
+		if err == nil {
+			t.Errorf("%q: expected an error", test.block)
+			continue
+		}
+		if err.Error() != test.err {
+			t.Errorf("%q: got error %q, want %q", test.block, err, test.err)
+		}
+	}
+}
+
+func TestEffectiveIncludes(t *testing.T) {
+	files := map[string]string{
+		"/etc/nginx/nginx.conf":   "http {\n    include headers.conf;\n    server {\n    }\n}\n",
+		"/etc/nginx/headers.conf": "add_header X-A a;\n",
+	}
+	tree, err := LoadDump(files, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	http := tree.Root.Nodes[0].(*DirectiveNode)
+	var server *DirectiveNode
+	for _, d := range directiveBlock(http) {
+		if d.Text == "server" {
+			server = d
+		}
+	}
+	e, err := Effective(tree, server)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"*add_header X-A a (/etc/nginx/headers.conf:1:1)"}
+	if got := settings(e); !reflect.DeepEqual(got, want) {
+		t.Errorf("got settings %q, want %q", got, want)
+	}
+}
+
+func TestEffectiveSameField(t *testing.T) {
+	tree, err := Parse("test", "http {\n    root /srv/www;\n    server {\n        location /img/ {\n            alias /srv/images/;\n            location /img/icons/ {\n                root /srv/icons;\n            }\n        }\n    }\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := directiveBlock(tree.Root.Nodes[0].(*DirectiveNode))[1]
+	img := directiveBlock(server)[0]
+
+	var tests = []struct {
+		block *DirectiveNode
+		want  []string
+	}{
+		{img, []string{"alias /srv/images/ (test:5:13)"}},
+		{directiveBlock(img)[1], []string{"root /srv/icons (test:7:17)"}},
+	}
+	for _, test := range tests {
+		e, err := Effective(tree, test.block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := settings(e); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got settings %q, want %q", test.block, got, test.want)
+		}
+	}
+}
+
+func TestEffectiveHandlers(t *testing.T) {
+	tree, err := Parse("test", "http {\n    server {\n        location /api/ {\n            proxy_pass http://backend;\n            location /api/static/ {\n                expires 1d;\n            }\n            limit_except GET {\n                deny all;\n            }\n        }\n    }\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := directiveBlock(tree.Root.Nodes[0].(*DirectiveNode))[0]
+	api := directiveBlock(server)[0]
+
+	var tests = []struct {
+		block *DirectiveNode
+		want  []string
+	}{
+		{directiveBlock(api)[1], []string{"expires 1d (test:6:17)"}},
+		{directiveBlock(api)[2], []string{"*proxy_pass http://backend (test:4:13)", "deny all (test:9:17)"}},
+	}
+	for _, test := range tests {
+		e, err := Effective(tree, test.block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := settings(e); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%q: got settings %q, want %q", test.block, got, test.want)
+		}
+	}
+}
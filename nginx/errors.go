@@ -0,0 +1,138 @@
+package nginx
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Severity describes how serious a problem found while parsing is.
+type Severity int
+
+const (
+	SeverityError   Severity = iota // the configuration is invalid.
+	SeverityWarning                 // the configuration is valid, but something was lost or is suspicious.
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return fmt.Sprintf("severity%d", int(s))
+}
+
+// ErrorKind identifies the class of a parse error; its string form is a stable code that
+// can be used to filter or suppress errors.
+type ErrorKind int
+
+const (
+	KindLex              ErrorKind = iota + 1 // the text can't be tokenized.
+	KindSyntax                                // the tokens don't form valid directives or blocks.
+	KindUnknownDirective                      // the directive is not known to the parser.
+	KindContext                               // the directive is not allowed in the current context.
+	KindArguments                             // the directive has the wrong number or type of arguments.
+	KindInclude                               // an included file can't be read.
+)
+
+var errorKindCodes = map[ErrorKind]string{
+	KindLex:              "lex",
+	KindSyntax:           "syntax",
+	KindUnknownDirective: "unknown-directive",
+	KindContext:          "context",
+	KindArguments:        "arguments",
+	KindInclude:          "include",
+}
+
+func (k ErrorKind) String() string {
+	if code, ok := errorKindCodes[k]; ok {
+		return code
+	}
+	return fmt.Sprintf("kind%d", int(k))
+}
+
+// ParseError describes a problem found while parsing a configuration file. Every error
+// returned by Tree.Parse is a *ParseError or an ErrorList, so callers can use errors.As to
+// inspect it; the functions that also read files, like Loader.Load, LoadDump and
+// ParseReader, can return other errors too, like those of the io and io/fs packages, when
+// the configuration can't be read.
+type ParseError struct {
+	Severity Severity
+	Kind     ErrorKind
+	Filename string
+	Line     int    // line number, starting at 1.
+	Column   int    // column number in bytes, starting at 1.
+	Offset   int    // offset in bytes from the start of the file, starting at 0.
+	Token    string // the offending token, if any.
+	Msg      string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.Filename, e.Line, e.Column, e.Msg)
+}
+
+// ErrorList is a list of parse errors; it is returned by Tree.Parse when the AllErrors mode
+// is set.
+type ErrorList []*ParseError
+
+func (l ErrorList) Error() string {
+	switch len(l) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l[0].Error()
+	}
+	return fmt.Sprintf("%s (and %d more errors)", l[0], len(l)-1)
+}
+
+// Unwrap returns the errors in the list, so that errors.Is and errors.As can inspect them; they
+// use it from Go 1.20.
+func (l ErrorList) Unwrap() []error {
+	errs := make([]error, len(l))
+	for i, e := range l {
+		errs[i] = e
+	}
+	return errs
+}
+
+// As finds the first error in the list that matches target, so that errors.As can inspect the
+// errors before Go 1.20, which ignores Unwrap methods returning a list.
+func (l ErrorList) As(target interface{}) bool {
+	for _, e := range l {
+		if errors.As(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Is reports whether any error in the list matches target, like As for errors.Is.
+func (l ErrorList) Is(target error) bool {
+	for _, e := range l {
+		if errors.Is(e, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns an error equivalent to this error list; if the list is empty, Err returns nil.
+func (l ErrorList) Err() error {
+	if len(l) == 0 {
+		return nil
+	}
+	return l
+}
+
+// sort sorts the list by filename and position; errors at the same position keep their order.
+func (l ErrorList) sort() {
+	sort.SliceStable(l, func(i, j int) bool {
+		a, b := l[i], l[j]
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return a.Offset < b.Offset
+	})
+}
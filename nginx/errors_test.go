@@ -0,0 +1,82 @@
+package nginx
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+)
+
+func TestParseErrorFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected ParseError
+	}{
+		{"lex", "http {\n  index 'foo\n}", ParseError{
+			Kind: KindLex, Filename: "test", Line: 2, Column: 9, Offset: 15, Token: "'foo\n}",
+			Msg: "unterminated quoted string",
+		}},
+		{"syntax", "http {\n  index foo\n}", ParseError{
+			Kind: KindSyntax, Filename: "test", Line: 3, Column: 1, Offset: 19, Token: "}",
+			Msg: `unexpected "}", expecting ";" or "{"`,
+		}},
+		{"unknown directive", "events {}\nfoo bar;", ParseError{
+			Kind: KindUnknownDirective, Filename: "test", Line: 2, Column: 1, Offset: 10, Token: "foo",
+			Msg: `unknown directive "foo"`,
+		}},
+		{"context", "events {\n\tlisten 80;\n}", ParseError{
+			Kind: KindContext, Filename: "test", Line: 2, Column: 2, Offset: 10, Token: "listen",
+			Msg: `directive "listen" is not allowed in NGX_EVENT_CONF`,
+		}},
+		{"arguments", "pid;", ParseError{
+			Kind: KindArguments, Filename: "test", Line: 1, Column: 1, Offset: 0, Token: "pid",
+			Msg: `invalid number of arguments in "pid" directive, expected NGX_CONF_TAKE1`,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse("test", tt.input)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("expected a *ParseError, got %T (%v)", err, err)
+			}
+			if *perr != tt.expected {
+				t.Fatalf("expected:\n\t%+v\ngot:\n\t%+v", tt.expected, *perr)
+			}
+		})
+	}
+}
+
+func TestErrorListAs(t *testing.T) {
+	tree := New("test")
+	tree.Mode = AllErrors
+	_, err := tree.Parse("foo;\npid;\n")
+
+	wrapped := fmt.Errorf("checking config: %w", err)
+
+	var errs ErrorList
+	if !errors.As(wrapped, &errs) || len(errs) != 2 {
+		t.Fatalf("expected an ErrorList with 2 errors, got %v", wrapped)
+	}
+
+	var perr *ParseError
+	if !errors.As(wrapped, &perr) {
+		t.Fatalf("expected errors.As to find a *ParseError in %v", wrapped)
+	}
+	if perr.Kind != KindUnknownDirective || perr.Token != "foo" {
+		t.Fatalf("unexpected first error: %+v", perr)
+	}
+	if perr.Kind.String() != "unknown-directive" {
+		t.Fatalf("unexpected error code: %s", perr.Kind)
+	}
+	// the As and Is methods work without the Unwrap of Go 1.20.
+	var first *ParseError
+	if !errs.As(&first) || first != errs[0] {
+		t.Fatalf("expected As to find the first error, got %v", first)
+	}
+	if !errs.Is(errs[1]) || errs.Is(fs.ErrNotExist) {
+		t.Fatalf("unexpected result of Is")
+	}
+}
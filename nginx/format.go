@@ -0,0 +1,265 @@
+package nginx
+
+import (
+	"bytes"
+	"strings"
+)
+
+// BraceStyle controls where the opening brace of a block is written.
+type BraceStyle int
+
+const (
+	BraceSameLine BraceStyle = iota // the brace follows the arguments of the directive.
+	BraceNextLine                   // the brace is on its own line, indented like the directive.
+)
+
+// Options controls the output of Format.
+type Options struct {
+	IndentWidth       int        // number of IndentChar characters for each level of indentation.
+	IndentChar        byte       // the indentation character, usually ' ' or '\t'.
+	AlignArguments    bool       // align the arguments of consecutive directives in a block.
+	MaxEmptyLines     int        // maximum number of consecutive empty lines; 0 removes them all.
+	BraceStyle        BraceStyle // where the opening brace of a block is written.
+	NormalizeQuotes   bool       // write single quoted arguments with double quotes, when possible.
+	TrimTrailingSpace bool       // remove trailing whitespace from comments and Lua code.
+}
+
+// DefaultOptions are the options used by "nginxp fmt" when no flags are given.
+var DefaultOptions = Options{
+	IndentWidth:       4,
+	IndentChar:        ' ',
+	MaxEmptyLines:     1,
+	NormalizeQuotes:   true,
+	TrimTrailingSpace: true,
+}
+
+// Format returns the configuration represented by tree, formatted according to opts. Unlike
+// Tree.WriteTo, Format ignores the original layout of the text: every directive and comment
+// starts on its own line, except for the trailing comments of the directives. Empty lines at
+// the start and at the end of a block are removed.
+func Format(tree *Tree, opts Options) []byte {
+	f := formatter{opts: opts, unit: strings.Repeat(string(opts.IndentChar), opts.IndentWidth)}
+	if tree.Root != nil {
+		f.list(tree.Root, "")
+	}
+	return f.buf.Bytes()
+}
+
+// formatter holds the state of Format.
+type formatter struct {
+	buf  bytes.Buffer
+	opts Options
+	unit string // one level of indentation.
+}
+
+// list writes the nodes of l, each one on its own line.
+func (f *formatter) list(l *ListNode, indent string) {
+	widths := f.alignment(l.Nodes)
+
+	written := false
+	empty := 0 // empty lines to write before the next node.
+	for i, n := range l.Nodes {
+		if e, ok := n.(*EmptyLineNode); ok {
+			if written && emptyLines(e) > empty {
+				empty = emptyLines(e)
+			}
+			continue
+		}
+
+		if empty > f.opts.MaxEmptyLines {
+			empty = f.opts.MaxEmptyLines
+		}
+		for ; empty > 0; empty-- {
+			f.buf.WriteByte('\n')
+		}
+
+		f.buf.WriteString(indent)
+		switch n := n.(type) {
+		case *CommentNode:
+			f.comment(n)
+			f.buf.WriteByte('\n')
+		case *DirectiveNode:
+			f.directive(n, indent, widths[i])
+		}
+		written = true
+	}
+}
+
+// comment writes a comment.
+func (f *formatter) comment(c *CommentNode) {
+	text := c.String()
+	if f.opts.TrimTrailingSpace {
+		text = strings.TrimRight(text, " \t")
+	}
+	f.buf.WriteString(text)
+}
+
+// directive writes a directive with its comments and terminates the line; the first argument
+// is written at column width+1, if the directive name is shorter than width. The lines
+// following an inline comment are indented by one more level.
+func (f *formatter) directive(d *DirectiveNode, indent string, width int) {
+	for _, c := range d.Leading {
+		f.comment(c)
+		f.buf.WriteByte('\n')
+		f.buf.WriteString(indent)
+	}
+
+	f.buf.WriteString(d.Text)
+
+	// sep writes the separator before the next argument.
+	newLine := false
+	sep := func() {
+		if newLine {
+			f.buf.WriteString(indent + f.unit)
+		} else {
+			f.buf.WriteByte(' ')
+		}
+		newLine = false
+	}
+
+	terminator := ";"
+	for i, arg := range withInline(d.Args, d.Inline) {
+		switch arg := arg.(type) {
+		case *CommentNode:
+			sep()
+			f.comment(arg)
+			f.buf.WriteByte('\n')
+			newLine = true
+		case *ArgumentNode:
+			if i == 0 && width > len(d.Text) {
+				f.buf.WriteString(strings.Repeat(" ", width-len(d.Text)))
+			}
+			sep()
+			f.buf.WriteString(f.argument(arg))
+		case *BlockNode:
+			f.brace(indent, newLine)
+			if len(blockNodes(arg.List.Nodes)) == 0 {
+				terminator = "}"
+				break
+			}
+			f.buf.WriteByte('\n')
+			f.list(arg.List, indent+f.unit)
+			f.buf.WriteString(indent)
+			terminator = "}"
+		case *BlockLua:
+			f.brace(indent, newLine)
+			f.lua(arg, indent)
+			terminator = "}"
+		}
+	}
+	if newLine && terminator == ";" {
+		f.buf.WriteString(indent)
+	}
+	f.buf.WriteString(terminator)
+
+	if d.Trailing != nil {
+		f.buf.WriteByte(' ')
+		f.comment(d.Trailing)
+	}
+	f.buf.WriteByte('\n')
+}
+
+// brace writes the opening brace of a block; newLine is true when the brace follows an inline
+// comment.
+func (f *formatter) brace(indent string, newLine bool) {
+	switch {
+	case newLine:
+		f.buf.WriteString(indent)
+	case f.opts.BraceStyle == BraceNextLine:
+		f.buf.WriteByte('\n')
+		f.buf.WriteString(indent)
+	default:
+		f.buf.WriteByte(' ')
+	}
+	f.buf.WriteByte('{')
+}
+
+// lua writes the Lua code of a block; the code is not indented again, because its layout
+// can't be changed safely without parsing it, but the closing bracket is aligned with the
+// directive.
+func (f *formatter) lua(bl *BlockLua, indent string) {
+	lines := append([]string(nil), bl.Lines...)
+	if n := len(lines); n > 1 {
+		// the code is on more than one line: the last line is followed by the closing bracket.
+		if f.opts.TrimTrailingSpace {
+			for i, line := range lines[:n-1] {
+				lines[i] = strings.TrimRight(line, " \t")
+			}
+		}
+		if strings.TrimSpace(lines[n-1]) == "" {
+			lines[n-1] = indent
+		}
+	}
+	f.buf.WriteString(strings.Join(lines, "\n"))
+}
+
+// argument returns the text of an argument, normalizing the quotes if requested.
+func (f *formatter) argument(a *ArgumentNode) string {
+	s := a.String()
+	if !f.opts.NormalizeQuotes {
+		return s
+	}
+	// the escape sequences are the same with both quotes, but a value containing double quotes
+	// is more readable in single quotes.
+	if v, quote := unquoteArg(s); quote == QuoteSingle && !strings.Contains(v, `"`) {
+		return quoteArg(v, QuoteDouble)
+	}
+	return s
+}
+
+// alignment returns, for every directive that is part of a group of consecutive directives
+// without a block, the length of the longest name in the group; the arguments of the group
+// are aligned at that column.
+func (f *formatter) alignment(nodes []Node) map[int]int {
+	widths := make(map[int]int)
+	if !f.opts.AlignArguments {
+		return widths
+	}
+
+	var group []int
+	width := 0
+	flush := func() {
+		if len(group) > 1 {
+			for _, i := range group {
+				widths[i] = width
+			}
+		}
+		group, width = nil, 0
+	}
+
+	for i, n := range nodes {
+		d, ok := n.(*DirectiveNode)
+		if !ok || len(d.Args) == 0 || isBlock(d.Args[len(d.Args)-1]) {
+			flush()
+			continue
+		}
+		group = append(group, i)
+		if len(d.Text) > width {
+			width = len(d.Text)
+		}
+	}
+	flush()
+	return widths
+}
+
+// blockNodes returns the nodes of a block that produce some output.
+func blockNodes(nodes []Node) []Node {
+	var res []Node
+	for _, n := range nodes {
+		if _, ok := n.(*EmptyLineNode); !ok {
+			res = append(res, n)
+		}
+	}
+	return res
+}
+
+// emptyLines returns the number of empty lines represented by an EmptyLineNode.
+func emptyLines(e *EmptyLineNode) int {
+	if e.tr == nil {
+		return 1
+	}
+	if n := strings.Count(e.tr.text[e.Pos:e.end], "\n") - 1; n > 1 {
+		return n
+	}
+	return 1
+}
@@ -0,0 +1,225 @@
+package nginx
+
+import (
+	"os"
+	"testing"
+)
+
+const formatInput = `# main
+user  nginx;
+worker_processes	auto;
+
+
+
+events{worker_connections 1024;}
+http {
+
+  default_type 'text/plain';
+  access_log /var/log/access.log   main;   # requests
+  sendfile on;
+  server {
+          listen 80;
+          location / { }
+  }
+
+}
+`
+
+func TestFormat(t *testing.T) {
+	var tests = []struct {
+		name     string
+		opts     func(o *Options)
+		expected string
+	}{
+		{
+			"default",
+			func(o *Options) {},
+			`# main
+user nginx;
+worker_processes auto;
+
+events {
+    worker_connections 1024;
+}
+http {
+    default_type "text/plain";
+    access_log /var/log/access.log main; # requests
+    sendfile on;
+    server {
+        listen 80;
+        location / {}
+    }
+}
+`,
+		},
+		{
+			"tabs and alignment",
+			func(o *Options) {
+				o.IndentChar = '\t'
+				o.IndentWidth = 1
+				o.AlignArguments = true
+			},
+			`# main
+user             nginx;
+worker_processes auto;
+
+events {
+	worker_connections 1024;
+}
+http {
+	default_type "text/plain";
+	access_log   /var/log/access.log main; # requests
+	sendfile     on;
+	server {
+		listen 80;
+		location / {}
+	}
+}
+`,
+		},
+		{
+			"keep everything",
+			func(o *Options) {
+				o.IndentWidth = 2
+				o.MaxEmptyLines = 2
+				o.BraceStyle = BraceNextLine
+				o.NormalizeQuotes = false
+				o.TrimTrailingSpace = false
+			},
+			`# main
+user nginx;
+worker_processes auto;
+
+
+events
+{
+  worker_connections 1024;
+}
+http
+{
+  default_type 'text/plain';
+  access_log /var/log/access.log main; # requests
+  sendfile on;
+  server
+  {
+    listen 80;
+    location /
+    {}
+  }
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := Parse("test", formatInput)
+			if err != nil {
+				t.Fatal(err)
+			}
+			opts := DefaultOptions
+			tt.opts(&opts)
+			if got := string(Format(tree, opts)); got != tt.expected {
+				t.Errorf("unexpected output:\n%s", got)
+			}
+		})
+	}
+}
+
+func TestFormatQuotes(t *testing.T) {
+	tree := New("test")
+	tree.Mode = SkipContextCheck
+	tree, err := tree.Parse(`add_header X-A 'a "b"'; add_header X-B 'c\'d'; add_header X-C '';`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `add_header X-A 'a "b"';
+add_header X-B "c'd";
+add_header X-C "";
+`
+	if got := string(Format(tree, DefaultOptions)); got != expected {
+		t.Errorf("unexpected output:\n%s", got)
+	}
+}
+
+func TestFormatComments(t *testing.T) {
+	input := `server {
+  # leading   
+  listen 80 # inline
+    default_server;  # trailing
+  location / { # about the block
+  }
+  # standalone
+}
+`
+	expected := `server {
+    # leading
+    listen 80 # inline
+        default_server; # trailing
+    location / {
+        # about the block
+    }
+    # standalone
+}
+`
+	tree := New("test")
+	tree.Mode = SkipContextCheck
+	tree, err := tree.Parse(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(Format(tree, DefaultOptions)); got != expected {
+		t.Errorf("unexpected output:\n%s", got)
+	}
+}
+
+func TestFormatLua(t *testing.T) {
+	input := `location / {
+  content_by_lua_block {   
+      local a = "{"   
+      ngx.say(a)
+      }
+  access_by_lua_block { ngx.exit(403) }
+}
+`
+	expected := `location / {
+    content_by_lua_block {
+      local a = "{"
+      ngx.say(a)
+    }
+    access_by_lua_block { ngx.exit(403) }
+}
+`
+	tree := New("test")
+	tree.Mode = SkipContextCheck
+	tree, err := tree.Parse(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(Format(tree, DefaultOptions)); got != expected {
+		t.Errorf("unexpected output:\n%s", got)
+	}
+}
+
+func TestFormatIdempotent(t *testing.T) {
+	b, err := os.ReadFile("testdata/nginx.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := DefaultOptions
+	opts.AlignArguments = true
+
+	tree, err := Parse("nginx.conf", string(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	once := Format(tree, opts)
+
+	tree, err = Parse("nginx.conf", string(once))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if twice := Format(tree, opts); string(twice) != string(once) {
+		t.Errorf("formatting is not idempotent:\n%s", twice)
+	}
+}
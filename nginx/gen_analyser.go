@@ -16,8 +16,8 @@ var (
 	pyAnalyzerURL = "https://raw.githubusercontent.com/nginxinc/crossplane/master/crossplane/analyzer.py"
 
 	directivesStart = regexp.MustCompile(`^DIRECTIVES = \{$`)
-	bitMaskDef      = regexp.MustCompile(`^NGX_`)
-	bitMaskDefAny   = regexp.MustCompile(`^NGX_ANY_CONF = \($`)
+	bitMaskDef      = regexp.MustCompile(`^ngx_`)
+	bitMaskDefAny   = regexp.MustCompile(`^ngx_ANY_CONF = \($`)
 	endPipe         = regexp.MustCompile(`\| *$`)
 	directiveDef    = regexp.MustCompile(`'([^']+)': \[`)
 	comment         = regexp.MustCompile(`^ *#`)
@@ -49,11 +49,12 @@ func generateAnalyser() error {
 		}
 	}()
 
-	fmt.Fprintf(fh, "package parse\n\n// THIS FILE IS AUTOGENERATED\n\nvar (\n")
+	fmt.Fprintf(fh, "package nginx\n\n// THIS FILE IS AUTOGENERATED\n\nconst (\n")
 
 	scanner := bufio.NewScanner(resp.Body)
 	for scanner.Scan() {
-		line := scanner.Text()
+		// the masks are only used by dirMask, so they are not exported.
+		line := strings.ReplaceAll(scanner.Text(), "NGX_", "ngx_")
 
 		if directivesStart.MatchString(line) {
 			context = 2
@@ -63,13 +64,13 @@ func generateAnalyser() error {
 		}
 
 		if context == 0 {
-			// start of: NGX_ANY_CONF = (
+			// start of: ngx_ANY_CONF = (
 			if bitMaskDefAny.MatchString(line) {
 				context = 1
 				fmt.Fprintln(fh, line)
 			} else if bitMaskDef.MatchString(line) {
 				// common bitmask declaration
-				// example: NGX_CONF_NOARGS = 0x00000001  # 0 args
+				// example: ngx_CONF_NOARGS = 0x00000001  # 0 args
 				line = strings.ReplaceAll(line, "#", "//")
 				fmt.Fprintln(fh, line)
 			}
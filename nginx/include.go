@@ -0,0 +1,224 @@
+package nginx
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+)
+
+// includer gives access to the files that can be included by a configuration.
+type includer interface {
+	// clean turns a path, as written in the configuration, into a name for glob and readFile.
+	clean(p string) string
+	// glob returns the names of the files matching pattern, in lexical order.
+	glob(pattern string) ([]string, error)
+	// readFile returns the contents of the named file.
+	readFile(name string) (string, error)
+}
+
+// includes holds the state needed to resolve include directives while parsing.
+type includes struct {
+	src    includer
+	prefix string   // directory used to resolve relative paths.
+	stack  []string // files being parsed, used to detect include cycles.
+}
+
+// Loader parses a configuration file together with all the files it includes, producing a
+// single merged Tree. Each include directive is replaced by the contents of the included
+// files, and is checked in the context where the include directive appears; the nodes coming
+// from an included file report its name in their Span.
+type Loader struct {
+	// FS is the file system the configuration is read from. Absolute paths are looked up
+	// without the leading slash, so FS usually represents the root of the file system, e.g.
+	// os.DirFS("/"); the tree and its nodes still report the paths with the slash.
+	FS fs.FS
+	// Prefix is the directory, inside FS, used to resolve relative include paths; the
+	// default is the directory of the main configuration file.
+	Prefix string
+	// Mode is the parsing mode used for all the files.
+	Mode Mode
+}
+
+// Load parses the configuration file name, which is a path inside l.FS, and all the files
+// it includes.
+func (l *Loader) Load(name string) (*Tree, error) {
+	prefix := l.Prefix
+	if prefix == "" {
+		prefix = path.Dir(name)
+	}
+	src := fsIncluder{l.FS}
+	return load(src, src.clean(name), src.clean(prefix), l.Mode)
+}
+
+// load parses the main configuration file name, resolving its includes from src.
+func load(src includer, name, prefix string, mode Mode) (*Tree, error) {
+	text, err := src.readFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	t := New(name)
+	t.Mode = mode
+	t.inc = &includes{src: src, prefix: prefix, stack: []string{name}}
+	return t.Parse(text)
+}
+
+// include parses the files included by the directive d and returns their nodes.
+func (t *Tree) include(d *DirectiveNode, ctx *context, validate bool) []Node {
+	if len(d.Args) != 1 {
+		// the wrong number of arguments has already been reported.
+		return []Node{d}
+	}
+	arg, ok := d.Args[0].(*ArgumentNode)
+	if !ok {
+		return []Node{d}
+	}
+	tok := item{typ: itemWord, pos: arg.Pos, val: arg.text}
+
+	pattern := arg.Value
+	if !path.IsAbs(pattern) {
+		pattern = path.Join(t.inc.prefix, pattern)
+	}
+	pattern = t.inc.src.clean(pattern)
+
+	names := []string{pattern}
+	if strings.ContainsAny(pattern, "*?[") {
+		var err error
+		if names, err = t.inc.src.glob(pattern); err != nil {
+			t.errorf(tok, KindInclude, "invalid include pattern %q: %s", pattern, err)
+			return nil
+		}
+	}
+
+	var nodes []Node
+	for _, name := range names {
+		for _, parent := range t.inc.stack {
+			if parent == name {
+				t.errorf(tok, KindInclude, "include cycle: %s includes %s", strings.Join(t.inc.stack, " -> "), name)
+				return nodes
+			}
+		}
+
+		text, err := t.inc.src.readFile(name)
+		if err != nil {
+			t.errorf(tok, KindInclude, "can't include %q: %s", name, err)
+			continue
+		}
+
+		sub := New(name)
+		sub.Mode = t.Mode
+		sub.inc = t.inc
+		t.inc.stack = append(t.inc.stack, name)
+		err = sub.parseIncluded(text, ctx, validate)
+		t.inc.stack = t.inc.stack[:len(t.inc.stack)-1]
+
+		t.warnings = append(t.warnings, sub.warnings...)
+		if err != nil {
+			// the error is already a *ParseError that refers to the included file.
+			panic(err)
+		}
+		t.errors = append(t.errors, sub.errors...)
+		nodes = append(nodes, sub.Root.Nodes...)
+	}
+	return nodes
+}
+
+// parseIncluded parses the text of an included file in the context ctx of the include directive.
+func (t *Tree) parseIncluded(text string, ctx *context, validate bool) (err error) {
+	defer t.recover(&err)
+
+	t.startParse(lex(t.Filename, text))
+	t.parse(ctx, validate)
+	t.stopParse()
+	return nil
+}
+
+// fsIncluder reads included files from a fs.FS.
+type fsIncluder struct {
+	fsys fs.FS
+}
+
+func (f fsIncluder) clean(p string) string {
+	return path.Clean(p)
+}
+
+// glob returns the names matching pattern with the leading slash of pattern, if any, which
+// fs.Glob doesn't accept.
+func (f fsIncluder) glob(pattern string) ([]string, error) {
+	names, err := fs.Glob(f.fsys, fsName(pattern))
+	if err != nil || !path.IsAbs(pattern) {
+		return names, err
+	}
+	for i := range names {
+		names[i] = "/" + names[i]
+	}
+	return names, nil
+}
+
+func (f fsIncluder) readFile(name string) (string, error) {
+	b, err := fs.ReadFile(f.fsys, fsName(name))
+	return string(b), err
+}
+
+// fsName returns the name of the file p in a fs.FS, where the absolute paths are not valid: it
+// removes the leading slash.
+func fsName(p string) string {
+	if p == "/" {
+		return "."
+	}
+	return strings.TrimPrefix(p, "/")
+}
+
+// LoadDump parses the main configuration file in files, which is the map returned by Unpack,
+// resolving the include directives with the other files of the map; the result is the whole
+// configuration loaded by nginx. The main file is the one named "nginx.conf", or the only file
+// in the map.
+func LoadDump(files map[string]string, mode Mode) (*Tree, error) {
+	var main string
+	for name := range files {
+		if len(files) == 1 || path.Base(name) == "nginx.conf" {
+			if main != "" {
+				return nil, fmt.Errorf("can't choose the main file between %q and %q", main, name)
+			}
+			main = name
+		}
+	}
+	if main == "" {
+		return nil, errors.New("main configuration file not found")
+	}
+
+	return load(mapIncluder(files), main, path.Dir(main), mode)
+}
+
+// mapIncluder reads included files from the sections of a configuration dump.
+type mapIncluder map[string]string
+
+func (m mapIncluder) clean(p string) string {
+	return path.Clean(p)
+}
+
+func (m mapIncluder) glob(pattern string) ([]string, error) {
+	var names []string
+	for name := range m {
+		matched, err := path.Match(pattern, name)
+		if err != nil {
+			return nil, err
+		}
+		if matched {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (m mapIncluder) readFile(name string) (string, error) {
+	text, ok := m[name]
+	if !ok {
+		return "", &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return text, nil
+}
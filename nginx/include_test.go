@@ -0,0 +1,198 @@
+package nginx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoader(t *testing.T) {
+	fsys := fstest.MapFS{
+		"etc/nginx/nginx.conf": {Data: []byte(`events {}
+http {
+    include mime.types;
+    include /etc/nginx/conf.d/*.conf;
+    include /etc/nginx/empty.d/*.conf;
+}
+`)},
+		"etc/nginx/mime.types": {Data: []byte("types {\n    text/html html;\n}\n")},
+		"etc/nginx/conf.d/a.conf": {Data: []byte(`server {
+    listen 80;
+    include snippets/common.conf;
+}
+`)},
+		"etc/nginx/conf.d/b.conf":            {Data: []byte("server {\n    listen 81;\n}\n")},
+		"etc/nginx/snippets/common.conf":     {Data: []byte("location / {\n    root /srv;\n}\n")},
+		"etc/nginx/conf.d/ignored.conf.save": {Data: []byte("invalid")},
+	}
+
+	loader := &Loader{FS: fsys}
+	tree, err := loader.Load("/etc/nginx/nginx.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := NewConfiguration(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	http := cfg.Directives[1]
+	var names []string
+	for _, d := range http.Block {
+		names = append(names, d.Name)
+	}
+	if strings.Join(names, " ") != "types server server" {
+		t.Fatalf("unexpected directives in http: %q", names)
+	}
+
+	// nodes remember the file they come from.
+	httpNode := tree.Root.Nodes[1].(*DirectiveNode)
+	block := httpNode.Args[0].(*BlockNode)
+	var files []string
+	for _, node := range block.List.Nodes {
+		if d, ok := node.(*DirectiveNode); ok {
+			files = append(files, d.Span().Start.String())
+		}
+	}
+	expected := "/etc/nginx/mime.types:1:1 /etc/nginx/conf.d/a.conf:1:1 /etc/nginx/conf.d/b.conf:1:1"
+	if strings.Join(files, " ") != expected {
+		t.Fatalf("expected positions %q, got %q", expected, files)
+	}
+	if tree.Filename != "/etc/nginx/nginx.conf" {
+		t.Fatalf("unexpected file name %q", tree.Filename)
+	}
+}
+
+func TestLoaderPrefix(t *testing.T) {
+	fsys := fstest.MapFS{
+		"conf/nginx.conf":   {Data: []byte("include conf/events.conf;\n")},
+		"conf/events.conf":  {Data: []byte("events {}\n")},
+		"conf/conf/ignored": {Data: []byte("")},
+	}
+
+	loader := &Loader{FS: fsys, Prefix: "."}
+	tree, err := loader.Load("conf/nginx.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := tree.Root.Nodes[0].(*DirectiveNode)
+	if d.Text != "events" || d.Span().Start.Filename != "conf/events.conf" {
+		t.Fatalf("unexpected node %q from %s", d.Text, d.Span().Start.Filename)
+	}
+}
+
+func TestLoaderErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		err  string
+	}{
+		{"cycle", fstest.MapFS{
+			"nginx.conf": {Data: []byte("include a.conf;\n")},
+			"a.conf":     {Data: []byte("include b.conf;\n")},
+			"b.conf":     {Data: []byte("include a.conf;\n")},
+		}, `b.conf:1:9: include cycle: nginx.conf -> a.conf -> b.conf includes a.conf`},
+		{"missing file", fstest.MapFS{
+			"nginx.conf": {Data: []byte("include missing.conf;\n")},
+		}, `nginx.conf:1:9: can't include "missing.conf": open missing.conf: file does not exist`},
+		{"context of the include", fstest.MapFS{
+			"nginx.conf":  {Data: []byte("http {\n    include server.conf;\n}\n")},
+			"server.conf": {Data: []byte("location / {}\n")},
+		}, `server.conf:1:1: directive "location" is not allowed in NGX_HTTP_MAIN_CONF`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loader := &Loader{FS: tt.fsys}
+			_, err := loader.Load("nginx.conf")
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("expected a *ParseError, got %T (%v)", err, err)
+			}
+			if perr.Error() != tt.err {
+				t.Fatalf("expected error %q, got %q", tt.err, perr)
+			}
+		})
+	}
+}
+
+func TestLoaderAllErrors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"nginx.conf": {Data: []byte("include a.conf;\nfoo;\n")},
+		"a.conf":     {Data: []byte("bar;\nevents {}\n")},
+	}
+
+	loader := &Loader{FS: fsys, Mode: AllErrors}
+	tree, err := loader.Load("nginx.conf")
+	var errs ErrorList
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("expected 2 errors, got %v", err)
+	}
+	if errs[0].Filename != "a.conf" || errs[1].Filename != "nginx.conf" {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if len(tree.Root.Nodes) != 3 {
+		t.Fatalf("expected 3 nodes in the merged tree, got %d", len(tree.Root.Nodes))
+	}
+}
+
+func TestLoadDump(t *testing.T) {
+	filename := "testdata/docker_nginx_t.conf"
+	files, err := Unpack(filename)
+	if err != nil {
+		t.Fatalf("failed unpacking %q: %s", filename, err)
+	}
+
+	tree, err := LoadDump(files, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Filename != "/etc/nginx/nginx.conf" {
+		t.Fatalf("unexpected main file %q", tree.Filename)
+	}
+
+	cfg, err := NewConfiguration(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var http *Directive
+	for _, d := range cfg.Directives {
+		if d.Name == "http" {
+			http = d
+		}
+	}
+	if http == nil {
+		t.Fatal("directive 'http' not found")
+	}
+
+	var names []string
+	for _, d := range http.Block {
+		names = append(names, d.Name)
+	}
+	expected := "types default_type log_format access_log sendfile keepalive_timeout server"
+	if strings.Join(names, " ") != expected {
+		t.Fatalf("expected %q, got %q", expected, strings.Join(names, " "))
+	}
+}
+
+func TestLoadDumpMainFile(t *testing.T) {
+	files := map[string]string{
+		"/etc/nginx/conf.d/a.conf": "server {}\n",
+		"/etc/nginx/conf.d/b.conf": "server {}\n",
+	}
+	if _, err := LoadDump(files, 0); err == nil {
+		t.Fatal("expected an error when the main file is missing")
+	}
+
+	files = map[string]string{"/tmp/snippet.conf": "events {}\n"}
+	tree, err := LoadDump(files, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Filename != "/tmp/snippet.conf" {
+		t.Fatalf("unexpected main file %q", tree.Filename)
+	}
+}
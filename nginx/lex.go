@@ -0,0 +1,461 @@
+// Some of this code is from https://go.dev/src/text/template/parse/
+
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nginx
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// errInvalidEncoding is the error reported for the bytes that are not valid UTF-8.
+const errInvalidEncoding = "invalid UTF-8 encoding"
+
+// itemType identifies the type of lex items.
+type itemType int
+
+// item represent a token or text strin returned from the scanner.
+type item struct {
+	typ  itemType // The type of this item.
+	pos  Pos      // The starting position, in bytes, of this item in the input string.
+	val  string   // The value of this item.
+	line int      // The line number at the start of this item.
+	text string   // For errors: the input that caused the error.
+}
+
+func (i item) String() string {
+	switch i.typ {
+	case itemEOF:
+		return "EOF"
+	case itemError:
+		return i.val
+	}
+	if len(i.val) > 20 {
+		return fmt.Sprintf("%.20q...", i.val)
+	}
+	return fmt.Sprintf("%q", i.val)
+}
+
+// stateFn represents the state of the scanner as a function that returns
+// the next state.
+type stateFn func(*lexer) stateFn
+
+// lexer holds the state of the scanner.
+type lexer struct {
+	name      string        // used only for error reports.
+	input     string        // the string being scanned; it grows while reading from r.
+	r         *bufio.Reader // the reader of the input, if any; it's nil after the end of the input.
+	err       error         // the error returned by r, other than io.EOF.
+	start     Pos           // start position of this item
+	pos       Pos           // current position in the input
+	width     Pos           // width of the last rune read
+	state     stateFn       // the next state function to run.
+	items     []item        // scanned items, not all returned yet.
+	head      int           // index of the next item returned by nextItem.
+	line      int           // 1+number of newlines seen
+	startLine int           // start line of this item
+	depth     int           // depth level of nested blocks
+	directive string        // the first word of the current directive, if any.
+	checked   Pos           // the input before this position has been checked for invalid encodings.
+}
+
+// emit queues an item, which will be returned by nextItem.
+func (l *lexer) emit(t itemType) {
+	l.items = append(l.items, item{t, l.start, l.input[l.start:l.pos], l.startLine, ""})
+	l.start = l.pos
+	l.startLine = l.line
+}
+
+// next returns the next rune in the input.
+func (l *lexer) next() (r rune) {
+	if len(l.input)-int(l.pos) < utf8.UTFMax {
+		l.fill()
+	}
+	if int(l.pos) >= len(l.input) {
+		l.width = 0
+		return eof
+	}
+	r, w := utf8.DecodeRuneInString(l.input[l.pos:])
+	if r == utf8.RuneError && w == 1 && l.pos >= l.checked {
+		// the error is queued before the item containing the byte, which is scanned as usual.
+		l.items = append(l.items, item{itemError, l.pos, errInvalidEncoding, l.line, l.input[l.pos : l.pos+1]})
+	}
+	l.width = Pos(w)
+	l.pos += l.width
+	if l.pos > l.checked {
+		l.checked = l.pos
+	}
+	if r == '\n' {
+		l.line++
+	}
+	return r
+}
+
+// ignore skips over the pending input before this point.
+func (l *lexer) ignore() {
+	l.start = l.pos
+	l.startLine = l.line
+}
+
+// backup steps back one rune.
+// Can be called only once per call of next.
+func (l *lexer) backup() {
+	l.pos -= l.width
+	if l.width == 1 && l.input[l.pos] == '\n' {
+		l.line--
+	}
+}
+
+// peek returns but does not consume the next rune in the input.
+func (l *lexer) peek() rune {
+	r := l.next()
+	l.backup()
+	return r
+}
+
+// accept consumes the next rune if it's from the valid set.
+func (l *lexer) accept(valid string) bool {
+	if strings.ContainsRune(valid, l.next()) {
+		return true
+	}
+	l.backup()
+	return false
+}
+
+// acceptRun consumes a run of runes from the valid set.
+func (l *lexer) acceptRun(valid string) {
+	for strings.ContainsRune(valid, l.next()) {
+	}
+	l.backup()
+}
+
+// errorf returns an error token, skips the pending input and resumes the scan from lexText,
+// so that the parser can report more than one error.
+func (l *lexer) errorf(format string, args ...interface{}) stateFn {
+	l.items = append(l.items, item{itemError, l.start, fmt.Sprintf(format, args...), l.startLine, l.input[l.start:l.pos]})
+	l.ignore()
+	return lexText
+}
+
+// nextItem returns the next item from the input; the lexer runs the state functions until
+// they emit at least one item. After the end of the input, nextItem keeps returning EOF.
+func (l *lexer) nextItem() item {
+	for l.head == len(l.items) {
+		if l.state == nil {
+			return item{typ: itemEOF, pos: l.pos, line: l.line}
+		}
+		l.items, l.head = l.items[:0], 0
+		l.state = l.state(l)
+	}
+	it := l.items[l.head]
+	l.head++
+	return it
+}
+
+// fill reads more input from the reader, if any, until the input contains at least a full rune
+// after the current position.
+func (l *lexer) fill() {
+	for l.r != nil && len(l.input)-int(l.pos) < utf8.UTFMax {
+		// the size of the reads grows with the input, so that the input is copied only a
+		// logarithmic number of times.
+		size := len(l.input)
+		if size < 4096 {
+			size = 4096
+		}
+		buf := make([]byte, size)
+		n, err := io.ReadAtLeast(l.r, buf, utf8.UTFMax)
+		l.input += string(buf[:n])
+		if err != nil {
+			if err != io.EOF && err != io.ErrUnexpectedEOF {
+				l.err = err
+			}
+			l.r = nil
+		}
+	}
+}
+
+// lex creates a new scanner for the input string. The scanner runs synchronously, when the
+// parser asks for the next item.
+func lex(name, input string) *lexer {
+	return &lexer{
+		name:      name,
+		input:     input,
+		state:     lexStart,
+		line:      1,
+		startLine: 1,
+	}
+}
+
+// lexReader creates a new scanner that reads its input from r; the input is read while
+// scanning, when the scanner needs more text. A read error ends the input.
+func lexReader(name string, r io.Reader) *lexer {
+	l := lex(name, "")
+	l.r = bufio.NewReader(r)
+	return l
+}
+
+// state functions
+
+// lexStart skips the byte order mark at the start of the input, if any.
+func lexStart(l *lexer) stateFn {
+	if l.next() == bom {
+		l.ignore()
+	} else {
+		l.backup()
+	}
+	return lexText
+}
+
+// lexText scans the input until EOF.
+func lexText(l *lexer) stateFn {
+	switch r := l.next(); {
+	case r == eof:
+		if l.depth > 0 {
+			l.depth = 0
+			return l.errorf("unclosed block")
+		}
+		l.emit(itemEOF)
+		return nil
+	case r == '"' || r == '\'':
+		return lexQuote
+	case r == '#':
+		l.ignore()
+		return lexComment
+	case r == ';':
+		l.emit(itemTerminator)
+		l.directive = ""
+		return lexText
+	case r == '{':
+		l.depth++
+		l.emit(itemLeftBlock)
+		if isLuaBlock(l.directive) {
+			return lexLua
+		}
+		l.directive = ""
+		return lexText
+	case r == '}':
+		l.directive = ""
+		l.depth--
+		if l.depth < 0 {
+			l.depth = 0
+			return l.errorf("unmatched closing block")
+		}
+		l.emit(itemRightBlock)
+		return lexText
+	case r == '\n':
+		l.emit(itemNewline)
+		return lexText
+	case isSpace(r):
+		l.ignore()
+		return lexText
+	default:
+		l.backup()
+		return lexWord
+	}
+}
+
+// lexQuote scans a quoted string (with either single or double quotes). Like in nginx, a
+// backslash escapes the next character, the string can span more lines and it must be followed
+// by a space, ';', '{' or ')'.
+func lexQuote(l *lexer) stateFn {
+	marker := rune(l.input[l.start])
+Loop:
+	for {
+		switch r := l.next(); {
+		case r == '\\':
+			if l.next() != eof {
+				break
+			}
+			fallthrough
+		case r == eof:
+			return l.errorf("unterminated quoted string")
+		case r == marker:
+			break Loop
+		}
+	}
+	l.emit(itemString)
+
+	switch r := l.peek(); {
+	case isSpace(r) || r == '\n' || r == ';' || r == '{' || r == ')' || r == eof:
+		return lexText
+	default:
+		l.next()
+		return l.errorf("unexpected %q after quoted string", string(r))
+	}
+}
+
+// lexComment scans a comment. The left marker is known to be present.
+func lexComment(l *lexer) stateFn {
+Loop:
+	for {
+		switch l.next() {
+		case eof, '\n':
+			l.backup()
+			break Loop
+		}
+	}
+	// the carriage return of a CRLF line ending is not part of the comment.
+	if l.pos > l.start && l.input[l.pos-1] == '\r' {
+		l.pos--
+	}
+	l.emit(itemComment)
+	return lexText
+}
+
+// lexWord scans a word, which can be a directive or an argument for a directive, following the
+// rules of ngx_conf_read_token(): a word is terminated by a space, ';' or the start of a new
+// block '{', unless the bracket follows a '$', as in "${variable}"; a backslash escapes the next
+// character, so that "\;" or "\{" don't end the word. A closing bracket is part of the word.
+func lexWord(l *lexer) stateFn {
+	variable := false
+Loop:
+	for {
+		switch r := l.next(); {
+		case r == '{' && variable:
+		case r == '\\':
+			variable = false
+			l.next()
+		case r == '$':
+			variable = true
+		case isSpace(r) || r == '\n' || r == ';' || r == '{' || r == eof:
+			break Loop
+		default:
+			variable = false
+		}
+	}
+	l.backup()
+	if l.directive == "" {
+		l.directive = l.input[l.start:l.pos]
+	}
+	l.emit(itemWord)
+	return lexText
+}
+
+// lexLua scans the body of a *_by_lua_block directive, which is Lua code, up to the bracket
+// closing the block; the brackets, quotes and '#' characters that are part of Lua strings,
+// comments and long brackets don't end the block. The opening bracket has been emitted.
+func lexLua(l *lexer) stateFn {
+	depth := 1
+	for {
+		switch r := l.next(); {
+		case r == eof:
+			// the unclosed block is reported by lexText.
+			l.emit(itemLua)
+			return lexText
+		case r == '{':
+			depth++
+		case r == '}':
+			depth--
+			if depth == 0 {
+				l.backup()
+				l.emit(itemLua)
+				l.next()
+				l.depth--
+				l.directive = ""
+				l.emit(itemRightBlock)
+				return lexText
+			}
+		case r == '"' || r == '\'':
+			if !l.luaString(r) {
+				return l.errorf("unterminated Lua string")
+			}
+		case r == '[':
+			l.backup()
+			if level := l.luaLongBracket(); level >= 0 {
+				if !l.luaLongString(level) {
+					return l.errorf("unterminated Lua long string")
+				}
+			} else {
+				l.next()
+			}
+		case r == '-' && l.peek() == '-':
+			l.next()
+			if level := l.luaLongBracket(); level >= 0 {
+				if !l.luaLongString(level) {
+					return l.errorf("unterminated Lua long comment")
+				}
+				break
+			}
+			for r := l.peek(); r != '\n' && r != eof; r = l.peek() {
+				l.next()
+			}
+		}
+	}
+}
+
+// luaString scans a Lua short string, whose opening quote has been consumed; it reports
+// whether the string is terminated.
+func (l *lexer) luaString(quote rune) bool {
+	for {
+		switch l.next() {
+		case '\\':
+			// an escaped newline is part of the string.
+			if l.next() == eof {
+				return false
+			}
+		case quote:
+			return true
+		case '\n', eof:
+			return false
+		}
+	}
+}
+
+// luaLongBracket consumes the opening long bracket of a Lua long string or comment, like "[==[",
+// and returns its level, i.e. the number of '=' characters; if the input doesn't start with an
+// opening long bracket, it consumes nothing and returns -1.
+func (l *lexer) luaLongBracket() int {
+	pos, line := l.pos, l.line
+	if l.next() != '[' {
+		l.pos, l.line = pos, line
+		return -1
+	}
+	level := 0
+	for l.peek() == '=' {
+		l.next()
+		level++
+	}
+	if l.next() != '[' {
+		l.pos, l.line = pos, line
+		return -1
+	}
+	return level
+}
+
+// luaLongString scans a Lua long string or comment up to the closing long bracket of the
+// given level; it reports whether the closing bracket was found.
+func (l *lexer) luaLongString(level int) bool {
+	for {
+		switch l.next() {
+		case eof:
+			return false
+		case ']':
+			n := 0
+			for l.peek() == '=' {
+				l.next()
+				n++
+			}
+			if n == level && l.peek() == ']' {
+				l.next()
+				return true
+			}
+		}
+	}
+}
+
+// isLuaBlock reports whether the block of the directive contains Lua code.
+func isLuaBlock(directive string) bool {
+	return strings.HasSuffix(directive, "_by_lua_block")
+}
+
+// isSpace reports whether r is a space; like in nginx, a carriage return is a space, so that a
+// CRLF line ending is just a newline.
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\r'
+}
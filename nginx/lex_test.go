@@ -0,0 +1,359 @@
+package nginx
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+)
+
+type lexTest struct {
+	name  string
+	input string
+	items []item
+}
+
+func mkItem(typ itemType, text string) item {
+	return item{
+		typ: typ,
+		val: text,
+	}
+}
+
+var (
+	tEOF         = mkItem(itemEOF, "")
+	tQuote       = mkItem(itemString, `"tis a string"`)
+	tQuoteMixed  = mkItem(itemString, `"tis 'a string"`)
+	tQuoteSingle = mkItem(itemString, `'it\'s a me'`)
+	tNewLine     = mkItem(itemNewline, "\n")
+	tTerm        = mkItem(itemTerminator, ";")
+)
+
+var lexTests = []lexTest{
+	{"empty", "", []item{tEOF}},
+	{"quote", `"tis a string"`, []item{tQuote, tEOF}},
+	{"quote mixed", `"tis 'a string"`, []item{tQuoteMixed, tEOF}},
+	{"quote single", `'it\'s a me'`, []item{tQuoteSingle, tEOF}},
+	{"comment", "# I'm a comment line", []item{
+		mkItem(itemComment, " I'm a comment line"),
+		tEOF,
+	}},
+	{"two comments", "# I'm a comment line\n#and more", []item{
+		mkItem(itemComment, " I'm a comment line"),
+		mkItem(itemNewline, "\n"),
+		mkItem(itemComment, "and more"),
+		tEOF,
+	}},
+	{"directive with arguments", `proxy_set_header Host "Foo-Bar";`, []item{
+		mkItem(itemWord, "proxy_set_header"),
+		mkItem(itemWord, "Host"),
+		mkItem(itemString, `"Foo-Bar"`),
+		mkItem(itemTerminator, ";"),
+		tEOF,
+	}},
+	{"words ending with comment", `proxy_set_header Host "Foo"; # a comment`, []item{
+		mkItem(itemWord, "proxy_set_header"),
+		mkItem(itemWord, "Host"),
+		mkItem(itemString, `"Foo"`),
+		mkItem(itemTerminator, ";"),
+		mkItem(itemComment, " a comment"),
+		tEOF,
+	}},
+	{"newline", "\n", []item{tNewLine, tEOF}},
+	{"newlines", "\n\n", []item{tNewLine, tNewLine, tEOF}},
+	{"word with newlines", "foo;\n\n", []item{
+		mkItem(itemWord, "foo"),
+		tTerm,
+		tNewLine,
+		tNewLine,
+		tEOF,
+	}},
+	{"word with variable", "access_by_lua_file code/${something};", []item{
+		mkItem(itemWord, "access_by_lua_file"),
+		mkItem(itemWord, "code/${something}"),
+		tTerm,
+		tEOF,
+	}},
+	{"lua block", "content_by_lua_block { ngx.say(\"}\") -- }\n }", []item{
+		mkItem(itemWord, "content_by_lua_block"),
+		mkItem(itemLeftBlock, "{"),
+		mkItem(itemLua, " ngx.say(\"}\") -- }\n "),
+		mkItem(itemRightBlock, "}"),
+		tEOF,
+	}},
+	{"lua long brackets", "log_by_lua_block {--[==[ } ]] ]==] local s = [[#{]] if a then b = {} end}", []item{
+		mkItem(itemWord, "log_by_lua_block"),
+		mkItem(itemLeftBlock, "{"),
+		mkItem(itemLua, "--[==[ } ]] ]==] local s = [[#{]] if a then b = {} end"),
+		mkItem(itemRightBlock, "}"),
+		tEOF,
+	}},
+	{"not lua", "location /by_lua_block {}", []item{
+		mkItem(itemWord, "location"),
+		mkItem(itemWord, "/by_lua_block"),
+		mkItem(itemLeftBlock, "{"),
+		mkItem(itemRightBlock, "}"),
+		tEOF,
+	}},
+	{"crlf", "user nginx; # web\r\n\r\nevents {}\r\n", []item{
+		mkItem(itemWord, "user"),
+		mkItem(itemWord, "nginx"),
+		tTerm,
+		mkItem(itemComment, " web"),
+		tNewLine,
+		tNewLine,
+		mkItem(itemWord, "events"),
+		mkItem(itemLeftBlock, "{"),
+		mkItem(itemRightBlock, "}"),
+		tNewLine,
+		tEOF,
+	}},
+	{"bom", "\uFEFFuser nginx;", []item{
+		mkItem(itemWord, "user"),
+		mkItem(itemWord, "nginx"),
+		tTerm,
+		tEOF,
+	}},
+	{"bom not at start", "user \uFEFF;", []item{
+		mkItem(itemWord, "user"),
+		mkItem(itemWord, "\uFEFF"),
+		tTerm,
+		tEOF,
+	}},
+	// errors
+	{"invalid utf-8", "user ngi\xffnx;", []item{
+		mkItem(itemWord, "user"),
+		mkItem(itemError, "invalid UTF-8 encoding"),
+	}},
+	{"unterminated lua string", "init_by_lua_block { local s = 'a\n }", []item{
+		mkItem(itemWord, "init_by_lua_block"),
+		mkItem(itemLeftBlock, "{"),
+		mkItem(itemError, "unterminated Lua string"),
+	}},
+	{"unclosed quoted string", `"I'm unclosed`, []item{
+		mkItem(itemError, "unterminated quoted string"),
+	}},
+}
+
+func collect(t *lexTest) (items []item) {
+	l := lex(t.name, t.input)
+	for {
+		item := l.nextItem()
+		items = append(items, item)
+		if item.typ == itemEOF || item.typ == itemError {
+			break
+		}
+	}
+	return
+}
+
+func equal(i1, i2 []item, checkPos bool) bool {
+	if len(i1) != len(i2) {
+		return false
+	}
+	for k := range i1 {
+		if i1[k].typ != i2[k].typ {
+			return false
+		}
+		if i1[k].val != i2[k].val {
+			return false
+		}
+		if checkPos && i1[k].pos != i2[k].pos {
+			return false
+		}
+		if checkPos && i1[k].line != i2[k].line {
+			return false
+		}
+	}
+	return true
+}
+
+func TestLex(t *testing.T) {
+	for _, tt := range lexTests {
+		t.Run(tt.name, func(t *testing.T) {
+			items := collect(&tt)
+			if !equal(items, tt.items, false) {
+				t.Fatalf("expected:\n\t%+v\ngot:\n\t%+v", tt.items, items)
+			}
+		})
+	}
+}
+
+// conformanceTests describe how ngx_conf_read_token() splits some inputs into statements; each
+// statement is made of the values of its words and of the ";" or "{" ending it, while a closing
+// bracket is a statement by itself.
+var conformanceTests = []struct {
+	name     string
+	input    string
+	expected [][]string
+	err      string
+}{
+	{"quoted regex with braces", `location ~ "^/(foo|bar){2}$" {}`, [][]string{
+		{"location", "~", "^/(foo|bar){2}$", "{"}, {"}"},
+	}, ""},
+	{"escaped braces", `location ~ ^/(foo|bar)\{2\}$ {}`, [][]string{
+		{"location", "~", `^/(foo|bar)\{2\}$`, "{"}, {"}"},
+	}, ""},
+	{"closing bracket in a word", `rewrite ^/(\d+)/a}$ /b;`, [][]string{
+		{"rewrite", `^/(\d+)/a}$`, "/b", ";"},
+	}, ""},
+	{"bracket ending a word", "a x{y;}", [][]string{
+		{"a", "x", "{"}, {"y", ";"}, {"}"},
+	}, ""},
+	{"variables", "set $a ${b}c$;", [][]string{
+		{"set", "$a", "${b}c$", ";"},
+	}, ""},
+	{"bracket after a variable name", "a ${x{y};}", [][]string{
+		{"a", "${x", "{"}, {"y}", ";"}, {"}"},
+	}, ""},
+	{"escaped space and semicolon", `return 200 a\ b\;c;`, [][]string{
+		{"return", "200", `a\ b\;c`, ";"},
+	}, ""},
+	{"escape at the start of a word", `a \"b;`, [][]string{
+		{"a", `"b`, ";"},
+	}, ""},
+	{"escape sequences", `a "b\"c" 'it\'s' "\t\\"`, [][]string{
+		{"a", `b"c`, "it's", "\t\\"},
+	}, ""},
+	{"quoted newline", "add_header X \"multi\nline\";", [][]string{
+		{"add_header", "X", "multi\nline", ";"},
+	}, ""},
+	{"words after quotes", `a "b";c "d"{}`, [][]string{
+		{"a", "b", ";"}, {"c", "d", "{"}, {"}"},
+	}, ""},
+	{"if condition", `if ($a = "b") {}`, [][]string{
+		{"if", "($a", "=", "b", ")", "{"}, {"}"},
+	}, ""},
+	{"hash inside a word", "a b#c;", [][]string{
+		{"a", "b#c", ";"},
+	}, ""},
+	{"comment between words", "a b #c;\n;", [][]string{
+		{"a", "b", ";"},
+	}, ""},
+	{"crlf", "a b;\r\nc\r\nd;\r\n", [][]string{
+		{"a", "b", ";"}, {"c", "d", ";"},
+	}, ""},
+	{"word at eof", "a b", [][]string{
+		{"a", "b"},
+	}, ""},
+	{"text after quotes", `a "b"c;`, nil, `unexpected "c" after quoted string`},
+	{"unterminated quotes", "a \"b;\n", nil, "unterminated quoted string"},
+}
+
+// statements splits the input into statements, as described by conformanceTests; it returns
+// the first error of the lexer.
+func statements(input string) ([][]string, error) {
+	var res [][]string
+	var stmt []string
+	l := lex("test", input)
+	for {
+		item := l.nextItem()
+		switch item.typ {
+		case itemEOF:
+			if len(stmt) > 0 {
+				res = append(res, stmt)
+			}
+			return res, nil
+		case itemError:
+			return nil, errors.New(item.val)
+		case itemWord, itemString:
+			value, _ := unquoteArg(item.val)
+			stmt = append(stmt, value)
+		case itemTerminator, itemLeftBlock:
+			res = append(res, append(stmt, item.val))
+			stmt = nil
+		case itemRightBlock:
+			res = append(res, []string{item.val})
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	for _, tt := range conformanceTests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := statements(tt.input)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("expected error %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprintf("%q", res) != fmt.Sprintf("%q", tt.expected) {
+				t.Fatalf("expected:\n\t%q\ngot:\n\t%q", tt.expected, res)
+			}
+		})
+	}
+}
+
+// chanLexer reproduces the previous design of the lexer, which ran in its own goroutine and
+// delivered the items through an unbuffered channel; it's used to compare the two designs.
+type chanLexer struct {
+	items chan item
+}
+
+func lexChan(name, input string) *chanLexer {
+	c := &chanLexer{items: make(chan item)}
+	go func() {
+		l := lex(name, input)
+		for {
+			item := l.nextItem()
+			c.items <- item
+			if item.typ == itemEOF {
+				break
+			}
+		}
+		close(c.items)
+	}()
+	return c
+}
+
+func (c *chanLexer) nextItem() item {
+	return <-c.items
+}
+
+// benchmarkInputs returns the inputs of the lexer benchmarks: the testdata configuration
+// files and a small snippet.
+func benchmarkInputs(b *testing.B) map[string]string {
+	inputs := map[string]string{
+		"snippet": "location / {\n    proxy_pass http://backend;\n}\n",
+	}
+	for name, filename := range map[string]string{"nginx.conf": "testdata/nginx.conf", "dump": "testdata/docker_nginx_t.conf"} {
+		data, err := os.ReadFile(filename)
+		if err != nil {
+			b.Fatal(err)
+		}
+		inputs[name] = string(data)
+	}
+	return inputs
+}
+
+func BenchmarkLex(b *testing.B) {
+	for name, input := range benchmarkInputs(b) {
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				l := lex(name, input)
+				for l.nextItem().typ != itemEOF {
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkLexChannel(b *testing.B) {
+	for name, input := range benchmarkInputs(b) {
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(input)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				l := lexChan(name, input)
+				for l.nextItem().typ != itemEOF {
+				}
+			}
+		})
+	}
+}
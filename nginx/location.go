@@ -0,0 +1,181 @@
+package nginx
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+// LocationModifier is the modifier of a location, which determines how its pattern is matched
+// against the URI of a request.
+type LocationModifier int
+
+const (
+	LocationPrefix        LocationModifier = iota // no modifier: the pattern is a prefix of the URI.
+	LocationExact                                 // "=": the pattern is the URI.
+	LocationPrefixNoRegex                         // "^~": a prefix that disables the regex locations.
+	LocationRegex                                 // "~": a case-sensitive regular expression.
+	LocationRegexCaseless                         // "~*": a case-insensitive regular expression.
+)
+
+func (m LocationModifier) String() string {
+	switch m {
+	case LocationExact:
+		return "="
+	case LocationPrefixNoRegex:
+		return "^~"
+	case LocationRegex:
+		return "~"
+	case LocationRegexCaseless:
+		return "~*"
+	}
+	return ""
+}
+
+// Location is the typed view of a location directive.
+type Location struct {
+	Modifier  LocationModifier
+	Pattern   string         // the prefix, the URI or the regular expression; for named locations, the name including the '@'.
+	Named     bool           // the location is a named location, like "@fallback".
+	Regexp    *regexp.Regexp // the compiled regular expression, when the pattern can be converted to RE2.
+	Warning   string         // why the regular expression could not be converted, if it couldn't.
+	Directive *DirectiveNode // the location directive.
+}
+
+// IsRegex reports whether the pattern of the location is a regular expression.
+func (l *Location) IsRegex() bool {
+	return l.Modifier == LocationRegex || l.Modifier == LocationRegexCaseless
+}
+
+// NewLocation returns the typed view of a location directive. The modifier can be a separate
+// argument or a prefix of the pattern, as in "location =/exact". The regular expressions are
+// written in the PCRE syntax used by nginx: they are converted to the RE2 syntax of the regexp
+// package when possible, otherwise Regexp is nil and Warning explains why.
+func NewLocation(d *DirectiveNode) (*Location, error) {
+	var args []string
+	for _, a := range d.Args {
+		if a, ok := a.(*ArgumentNode); ok {
+			args = append(args, a.Value)
+		}
+	}
+
+	loc := &Location{Directive: d}
+	switch len(args) {
+	case 2:
+		switch args[0] {
+		case "=":
+			loc.Modifier = LocationExact
+		case "^~":
+			loc.Modifier = LocationPrefixNoRegex
+		case "~":
+			loc.Modifier = LocationRegex
+		case "~*":
+			loc.Modifier = LocationRegexCaseless
+		default:
+			return nil, nodeError(d, "invalid location modifier %q", args[0])
+		}
+		loc.Pattern = args[1]
+	case 1:
+		p := args[0]
+		switch {
+		case strings.HasPrefix(p, "="):
+			loc.Modifier, p = LocationExact, p[1:]
+		case strings.HasPrefix(p, "^~"):
+			loc.Modifier, p = LocationPrefixNoRegex, p[2:]
+		case strings.HasPrefix(p, "~*"):
+			loc.Modifier, p = LocationRegexCaseless, p[2:]
+		case strings.HasPrefix(p, "~"):
+			loc.Modifier, p = LocationRegex, p[1:]
+		case strings.HasPrefix(p, "@"):
+			loc.Named = true
+		}
+		loc.Pattern = p
+	default:
+		return nil, nodeError(d, "invalid number of arguments in \"location\" directive")
+	}
+
+	if loc.IsRegex() {
+		re, err := convertPCRE(loc.Pattern)
+		if err == nil {
+			if loc.Modifier == LocationRegexCaseless {
+				re = "(?i)" + re
+			}
+			loc.Regexp, err = regexp.Compile(re)
+		}
+		if err != nil {
+			loc.Warning = "can't convert the regular expression " + quoteArg(loc.Pattern, QuoteNone) + " to RE2: " + err.Error()
+		}
+	}
+	return loc, nil
+}
+
+// repeatOpen matches the start of a repetition like "{2,3}", up to the closing bracket.
+var repeatOpen = regexp.MustCompile(`\{[0-9]+(,[0-9]*)?$`)
+
+// convertPCRE converts a PCRE regular expression to the RE2 syntax, as far as possible: the
+// named groups "(?<name>...)" and "(?'name'...)" are rewritten as "(?P<name>...)", while the
+// features that RE2 lacks, like backreferences, lookaround assertions, atomic groups and
+// possessive quantifiers, are reported as errors. The other differences are left to
+// regexp.Compile.
+func convertPCRE(re string) (string, error) {
+	var b strings.Builder
+	inClass := false    // inside a character class.
+	quantified := false // the last character was a quantifier.
+	for i := 0; i < len(re); i++ {
+		c := re[i]
+		wasQuantified := quantified
+		quantified = false
+
+		switch {
+		case c == '\\' && i+1 < len(re):
+			if !inClass && (re[i+1] >= '1' && re[i+1] <= '9' || re[i+1] == 'g' || re[i+1] == 'k') {
+				return "", errors.New("backreferences are not supported")
+			}
+			b.WriteString(re[i : i+2])
+			i++
+			continue
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			b.WriteByte(c)
+			// a closing bracket at the start of the class is part of it.
+			if strings.HasPrefix(re[i+1:], "^") {
+				b.WriteByte('^')
+				i++
+			}
+			if strings.HasPrefix(re[i+1:], "]") {
+				b.WriteByte(']')
+				i++
+			}
+			continue
+		case c == '(' && strings.HasPrefix(re[i:], "(?<") && !strings.HasPrefix(re[i:], "(?<=") && !strings.HasPrefix(re[i:], "(?<!"):
+			b.WriteString("(?P<")
+			i += 2
+			continue
+		case c == '(' && strings.HasPrefix(re[i:], "(?'"):
+			end := strings.IndexByte(re[i+3:], '\'')
+			if end < 0 {
+				return "", errors.New("unterminated group name")
+			}
+			b.WriteString("(?P<" + re[i+3:i+3+end] + ">")
+			i += 3 + end
+			continue
+		case c == '(' && (strings.HasPrefix(re[i:], "(?=") || strings.HasPrefix(re[i:], "(?!") ||
+			strings.HasPrefix(re[i:], "(?<=") || strings.HasPrefix(re[i:], "(?<!")):
+			return "", errors.New("lookaround assertions are not supported")
+		case c == '(' && strings.HasPrefix(re[i:], "(?>"):
+			return "", errors.New("atomic groups are not supported")
+		case c == '+' && wasQuantified:
+			return "", errors.New("possessive quantifiers are not supported")
+		case c == '*' || c == '+' || c == '?':
+			quantified = true
+		case c == '}':
+			quantified = repeatOpen.MatchString(b.String())
+		}
+		b.WriteByte(c)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,105 @@
+package nginx
+
+import "testing"
+
+func TestNewLocation(t *testing.T) {
+	var tests = []struct {
+		input    string
+		modifier LocationModifier
+		pattern  string
+		named    bool
+		regexp   string
+	}{
+		{"location / {}", LocationPrefix, "/", false, ""},
+		{"location = /exact {}", LocationExact, "/exact", false, ""},
+		{"location =/exact {}", LocationExact, "/exact", false, ""},
+		{"location ^~ /prefix {}", LocationPrefixNoRegex, "/prefix", false, ""},
+		{`location ~* \.php$ {}`, LocationRegexCaseless, `\.php$`, false, `(?i)\.php$`},
+		{`location ~\.php$ {}`, LocationRegex, `\.php$`, false, `\.php$`},
+		{`location ~ "^/(foo|bar){2}$" {}`, LocationRegex, "^/(foo|bar){2}$", false, "^/(foo|bar){2}$"},
+		{`location ~ ^/(?<user>[^/]+)/ {}`, LocationRegex, "^/(?<user>[^/]+)/", false, "^/(?P<user>[^/]+)/"},
+		{`location ~ "^/(?'id'\d+)$" {}`, LocationRegex, `^/(?'id'\d+)$`, false, `^/(?P<id>\d+)$`},
+		{"location @fallback {}", LocationPrefix, "@fallback", true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d := parseHTTPDirective(t, "server {\n"+tt.input+"\n}").Args[0].(*BlockNode).List.Nodes[0].(*DirectiveNode)
+			loc, err := NewLocation(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if loc.Modifier != tt.modifier || loc.Pattern != tt.pattern || loc.Named != tt.named || loc.Directive != d {
+				t.Fatalf("unexpected location: %+v", loc)
+			}
+			if loc.Warning != "" {
+				t.Fatalf("unexpected warning: %s", loc.Warning)
+			}
+			if (loc.Regexp == nil) != (tt.regexp == "") || loc.Regexp != nil && loc.Regexp.String() != tt.regexp {
+				t.Fatalf("expected regexp %q, got %v", tt.regexp, loc.Regexp)
+			}
+		})
+	}
+}
+
+func TestLocationWarnings(t *testing.T) {
+	var tests = []struct {
+		pattern string
+		warning string
+	}{
+		{`^/(?!admin)`, "lookaround assertions are not supported"},
+		{`(?<=a)b`, "lookaround assertions are not supported"},
+		{`^/(\w)\1`, "backreferences are not supported"},
+		{`^/(?>a+)`, "atomic groups are not supported"},
+		{`^/a++`, "possessive quantifiers are not supported"},
+		{`^/a{2}+`, "possessive quantifiers are not supported"},
+		{`^/\Z`, "error parsing regexp: invalid escape sequence: `\\Z`"},
+	}
+	for _, tt := range tests {
+		tree := New("test")
+		tree.Mode = AllErrors | SkipContextCheck
+		tree, err := tree.Parse("location ~ '" + tt.pattern + "' {}")
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tt.pattern, err)
+			continue
+		}
+		errs := tree.Warnings()
+		if len(errs) != 1 || errs[0].Severity != SeverityWarning {
+			t.Errorf("%s: expected a warning, got %v", tt.pattern, errs)
+			continue
+		}
+
+		loc, err := NewLocation(tree.Root.Nodes[0].(*DirectiveNode))
+		if err != nil {
+			t.Fatal(err)
+		}
+		expected := "can't convert the regular expression " + quoteArg(tt.pattern, QuoteNone) + " to RE2: " + tt.warning
+		if loc.Regexp != nil || loc.Warning != expected || errs[0].Msg != expected {
+			t.Errorf("%s: unexpected warning %q", tt.pattern, loc.Warning)
+		}
+	}
+
+	// the features supported by RE2 are accepted.
+	for _, pattern := range []string{`^/a+?b*?`, `[]+]+`, `a}+`, `\++`} {
+		if _, err := convertPCRE(pattern); err != nil {
+			t.Errorf("%s: unexpected error %s", pattern, err)
+		}
+	}
+}
+
+func TestLocationWarningsDefaultMode(t *testing.T) {
+	tree, err := Parse("test", "http {\n    server {\n        location ~ ^/(?!admin) {}\n    }\n}\n")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	warnings := tree.Warnings()
+	if len(warnings) != 1 || warnings[0].Line != 3 || warnings[0].Severity != SeverityWarning {
+		t.Errorf("unexpected warnings: %v", warnings)
+	}
+}
+
+func TestLocationModifierError(t *testing.T) {
+	_, err := Parse("test", "http {\n    server {\n        location ~~ /a {}\n    }\n}\n")
+	if err == nil || err.Error() != `test:3:9: invalid location modifier "~~"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
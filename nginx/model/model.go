@@ -0,0 +1,401 @@
+// Package model is a typed view of an nginx configuration, built on the directives of an
+// nginx.Configuration: the http, stream, mail and events blocks, their servers, locations and
+// upstreams are Go structs with the values of the directives that describe them. Each struct
+// keeps the directive it comes from, so that the tools using the model can still reach the
+// other directives, and the position of the directive in the configuration files.
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/piger/nginxp/nginx"
+)
+
+// Config is the typed view of a whole configuration.
+type Config struct {
+	Events        *Events
+	HTTP          *HTTP
+	Stream        *Stream
+	Mail          *Mail
+	Configuration *nginx.Configuration
+}
+
+// Events is the events block.
+type Events struct {
+	WorkerConnections int    // 0 when not set; nginx defaults to 512.
+	Use               string // the connection processing method, like "epoll".
+	MultiAccept       bool
+	Directive         *nginx.Directive
+}
+
+// HTTP is the http block.
+type HTTP struct {
+	Servers   []*Server
+	Upstreams []*Upstream
+	Directive *nginx.Directive
+}
+
+// Server is a server block of the http context.
+type Server struct {
+	Names     []string
+	Listens   []*nginx.Listen // the listen directives; empty for the implicit "listen *:80".
+	Locations []*Location
+	Directive *nginx.Directive
+}
+
+// Location is a location block, with the locations nested in it.
+type Location struct {
+	Modifier  nginx.LocationModifier
+	Pattern   string
+	Named     bool
+	ProxyPass string // the argument of the proxy_pass directive, if any.
+	Locations []*Location
+	Directive *nginx.Directive
+}
+
+// Upstream is an upstream block of the http or the stream context.
+type Upstream struct {
+	Name       string
+	Method     string   // the load balancing method: "round_robin", "least_conn", "ip_hash", "hash", "random" or "least_time".
+	MethodArgs []string // the arguments of the load balancing directive, like the key of hash.
+	Keepalive  int      // the number of idle connections kept by each worker; 0 when not set.
+	Servers    []*UpstreamServer
+	Directive  *nginx.Directive
+}
+
+// UpstreamServer is a server directive of an upstream block; the fields have the defaults of
+// nginx when the parameters are missing.
+type UpstreamServer struct {
+	Address     string // an address with an optional port, a host name or "unix:" and a path.
+	Weight      int
+	MaxFails    int
+	FailTimeout string
+	MaxConns    int // 0 means no limit.
+	Backup      bool
+	Down        bool
+	Resolve     bool
+	Directive   *nginx.Directive
+}
+
+// Stream is the stream block.
+type Stream struct {
+	Servers   []*StreamServer
+	Upstreams []*Upstream
+	Directive *nginx.Directive
+}
+
+// StreamServer is a server block of the stream context.
+type StreamServer struct {
+	Listens   []string // the addresses of the listen directives.
+	ProxyPass string
+	Directive *nginx.Directive
+}
+
+// Mail is the mail block.
+type Mail struct {
+	Servers   []*MailServer
+	Directive *nginx.Directive
+}
+
+// MailServer is a server block of the mail context.
+type MailServer struct {
+	Names     []string
+	Listens   []string // the addresses of the listen directives.
+	Protocol  string   // "imap", "pop3" or "smtp"; empty when not set.
+	Directive *nginx.Directive
+}
+
+// New returns the typed view of cfg. When a block appears more than once, like two http blocks,
+// the servers and the upstreams of all of them are collected, and Directive is the first one.
+func New(cfg *nginx.Configuration) (*Config, error) {
+	c := &Config{Configuration: cfg}
+	for _, d := range cfg.Directives {
+		var err error
+		switch d.Name {
+		case "events":
+			c.Events, err = newEvents(d)
+		case "http":
+			if c.HTTP == nil {
+				c.HTTP = &HTTP{Directive: d}
+			}
+			err = c.HTTP.add(d)
+		case "stream":
+			if c.Stream == nil {
+				c.Stream = &Stream{Directive: d}
+			}
+			err = c.Stream.add(d)
+		case "mail":
+			if c.Mail == nil {
+				c.Mail = &Mail{Directive: d}
+			}
+			c.Mail.add(d)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func newEvents(d *nginx.Directive) (*Events, error) {
+	e := &Events{Directive: d}
+	for _, sub := range d.Block {
+		var err error
+		switch sub.Name {
+		case "worker_connections":
+			e.WorkerConnections, err = number(sub, 0)
+		case "use":
+			e.Use = arg(sub, 0)
+		case "multi_accept":
+			e.MultiAccept = arg(sub, 0) == "on"
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// add adds the servers and the upstreams of the http block d.
+func (h *HTTP) add(d *nginx.Directive) error {
+	for _, sub := range d.Block {
+		switch sub.Name {
+		case "server":
+			s, err := newServer(sub)
+			if err != nil {
+				return err
+			}
+			h.Servers = append(h.Servers, s)
+		case "upstream":
+			u, err := NewUpstream(sub)
+			if err != nil {
+				return err
+			}
+			h.Upstreams = append(h.Upstreams, u)
+		}
+	}
+	return nil
+}
+
+// Upstream returns the upstream with the given name, or nil.
+func (h *HTTP) Upstream(name string) *Upstream {
+	return findUpstream(h.Upstreams, name)
+}
+
+func newServer(d *nginx.Directive) (*Server, error) {
+	s := &Server{Directive: d}
+	for _, sub := range d.Block {
+		switch sub.Name {
+		case "server_name":
+			s.Names = append(s.Names, sub.Args...)
+		case "listen":
+			l, err := nginx.NewListen(node(sub))
+			if err != nil {
+				return nil, nodeError(sub, err)
+			}
+			s.Listens = append(s.Listens, l)
+		case "location":
+			l, err := newLocation(sub)
+			if err != nil {
+				return nil, err
+			}
+			s.Locations = append(s.Locations, l)
+		}
+	}
+	return s, nil
+}
+
+func newLocation(d *nginx.Directive) (*Location, error) {
+	loc, err := nginx.NewLocation(node(d))
+	if err != nil {
+		return nil, nodeError(d, err)
+	}
+	l := &Location{Modifier: loc.Modifier, Pattern: loc.Pattern, Named: loc.Named, Directive: d}
+	for _, sub := range d.Block {
+		switch sub.Name {
+		case "proxy_pass":
+			l.ProxyPass = arg(sub, 0)
+		case "location":
+			nested, err := newLocation(sub)
+			if err != nil {
+				return nil, err
+			}
+			l.Locations = append(l.Locations, nested)
+		}
+	}
+	return l, nil
+}
+
+// NewUpstream returns the typed view of an upstream directive of the http or the stream
+// context.
+func NewUpstream(d *nginx.Directive) (*Upstream, error) {
+	if d.Name != "upstream" || len(d.Args) != 1 {
+		return nil, errorf(d, "invalid %q directive", d.Name)
+	}
+	u := &Upstream{Name: d.Args[0], Method: "round_robin", Directive: d}
+	for _, sub := range d.Block {
+		switch sub.Name {
+		case "server":
+			s, err := newUpstreamServer(sub)
+			if err != nil {
+				return nil, err
+			}
+			u.Servers = append(u.Servers, s)
+		case "least_conn", "ip_hash", "hash", "random", "least_time":
+			u.Method, u.MethodArgs = sub.Name, sub.Args
+		case "keepalive":
+			n, err := number(sub, 0)
+			if err != nil {
+				return nil, err
+			}
+			u.Keepalive = n
+		}
+	}
+	return u, nil
+}
+
+// newUpstreamServer parses a server directive of an upstream block, like
+// ngx_http_upstream_server().
+func newUpstreamServer(d *nginx.Directive) (*UpstreamServer, error) {
+	if len(d.Args) == 0 {
+		return nil, errorf(d, "invalid number of arguments in \"server\" directive")
+	}
+	s := &UpstreamServer{Address: d.Args[0], Weight: 1, MaxFails: 1, FailTimeout: "10s", Directive: d}
+	for _, p := range d.Args[1:] {
+		name, value, hasValue := strings.Cut(p, "=")
+		var err error
+		switch {
+		case name == "weight" && hasValue:
+			s.Weight, err = strconv.Atoi(value)
+		case name == "max_fails" && hasValue:
+			s.MaxFails, err = strconv.Atoi(value)
+		case name == "max_conns" && hasValue:
+			s.MaxConns, err = strconv.Atoi(value)
+		case name == "fail_timeout" && hasValue:
+			s.FailTimeout = value
+		case p == "backup":
+			s.Backup = true
+		case p == "down":
+			s.Down = true
+		case p == "resolve":
+			s.Resolve = true
+		case hasValue && (name == "slow_start" || name == "route" || name == "service"), p == "drain":
+			// the parameters of the commercial subscription don't change the model.
+		default:
+			return nil, errorf(d, "invalid parameter %q", p)
+		}
+		if err != nil || s.Weight < 1 || s.MaxFails < 0 || s.MaxConns < 0 {
+			return nil, errorf(d, "invalid parameter %q", p)
+		}
+	}
+	return s, nil
+}
+
+// add adds the servers and the upstreams of the stream block d.
+func (s *Stream) add(d *nginx.Directive) error {
+	for _, sub := range d.Block {
+		switch sub.Name {
+		case "server":
+			srv := &StreamServer{Directive: sub}
+			for _, dd := range sub.Block {
+				switch dd.Name {
+				case "listen":
+					srv.Listens = append(srv.Listens, arg(dd, 0))
+				case "proxy_pass":
+					srv.ProxyPass = arg(dd, 0)
+				}
+			}
+			s.Servers = append(s.Servers, srv)
+		case "upstream":
+			u, err := NewUpstream(sub)
+			if err != nil {
+				return err
+			}
+			s.Upstreams = append(s.Upstreams, u)
+		}
+	}
+	return nil
+}
+
+// Upstream returns the upstream with the given name, or nil.
+func (s *Stream) Upstream(name string) *Upstream {
+	return findUpstream(s.Upstreams, name)
+}
+
+// add adds the servers of the mail block d.
+func (m *Mail) add(d *nginx.Directive) {
+	for _, sub := range d.Block {
+		if sub.Name != "server" {
+			continue
+		}
+		srv := &MailServer{Directive: sub}
+		for _, dd := range sub.Block {
+			switch dd.Name {
+			case "server_name":
+				srv.Names = append(srv.Names, dd.Args...)
+			case "listen":
+				srv.Listens = append(srv.Listens, arg(dd, 0))
+			case "protocol":
+				srv.Protocol = arg(dd, 0)
+			}
+		}
+		m.Servers = append(m.Servers, srv)
+	}
+}
+
+func findUpstream(upstreams []*Upstream, name string) *Upstream {
+	for _, u := range upstreams {
+		if u.Name == name {
+			return u
+		}
+	}
+	return nil
+}
+
+// arg returns the i-th argument of d, or an empty string.
+func arg(d *nginx.Directive, i int) string {
+	if i < len(d.Args) {
+		return d.Args[i]
+	}
+	return ""
+}
+
+// number returns the i-th argument of d as a non-negative number.
+func number(d *nginx.Directive, i int) (int, error) {
+	n, err := strconv.Atoi(arg(d, i))
+	if err != nil || n < 0 {
+		return 0, errorf(d, "invalid number %q in %q directive", arg(d, i), d.Name)
+	}
+	return n, nil
+}
+
+// node returns the node of d or, when d doesn't come from a parsed tree, like a Configuration
+// decoded from JSON, a node with the name and the arguments of d.
+func node(d *nginx.Directive) *nginx.DirectiveNode {
+	if d.Node != nil {
+		return d.Node
+	}
+	return nginx.NewDirective(d.Name, d.Args...)
+}
+
+// nodeError returns err, the error about the node of d, without the position of the node
+// returned by node when d has none.
+func nodeError(d *nginx.Directive, err error) error {
+	var perr *nginx.ParseError
+	if d.Node == nil && errors.As(err, &perr) {
+		return errors.New(perr.Msg)
+	}
+	return err
+}
+
+// errorf returns an error about d, with its position when it's known.
+func errorf(d *nginx.Directive, format string, args ...interface{}) error {
+	msg := fmt.Sprintf(format, args...)
+	if d.Node == nil {
+		return errors.New(msg)
+	}
+	return fmt.Errorf("%s: %s", d.Node.Span().Start, msg)
+}
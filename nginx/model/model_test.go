@@ -0,0 +1,227 @@
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/piger/nginxp/nginx"
+)
+
+const modelConfig = `events {
+    worker_connections 1024;
+    use epoll;
+    multi_accept on;
+}
+http {
+    upstream backend {
+        least_conn;
+        keepalive 32;
+        server 10.0.0.1:8080 weight=5 max_fails=3 fail_timeout=30s;
+        server 10.0.0.2:8080 max_conns=100 backup;
+        server unix:/run/app.sock down;
+    }
+    upstream hashed {
+        hash $request_uri consistent;
+        server app.internal resolve;
+    }
+    server {
+        listen 443 ssl default_server;
+        server_name example.com www.example.com;
+        location / {
+            proxy_pass http://backend;
+            location ~* \.png$ {
+            }
+        }
+        location @fallback {
+        }
+    }
+}
+stream {
+    upstream dns {
+        server 10.0.0.53:53;
+    }
+    server {
+        listen 53 udp;
+        proxy_pass dns;
+    }
+}
+mail {
+    server {
+        listen 143;
+        protocol imap;
+        server_name mail.example.com;
+    }
+}
+`
+
+func newConfig(t *testing.T, text string) (*Config, error) {
+	t.Helper()
+	tree, err := nginx.Parse("test", text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := nginx.NewConfiguration(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return New(cfg)
+}
+
+func TestNew(t *testing.T) {
+	c, err := newConfig(t, modelConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if e := c.Events; e == nil || e.WorkerConnections != 1024 || e.Use != "epoll" || !e.MultiAccept {
+		t.Errorf("unexpected events: %+v", e)
+	}
+
+	if c.HTTP == nil || len(c.HTTP.Servers) != 1 || len(c.HTTP.Upstreams) != 2 {
+		t.Fatalf("unexpected http: %+v", c.HTTP)
+	}
+	s := c.HTTP.Servers[0]
+	if !reflect.DeepEqual(s.Names, []string{"example.com", "www.example.com"}) {
+		t.Errorf("got server names %q", s.Names)
+	}
+	if len(s.Listens) != 1 || s.Listens[0].Port != 443 || !s.Listens[0].SSL || !s.Listens[0].DefaultServer {
+		t.Errorf("unexpected listen: %+v", s.Listens)
+	}
+	if len(s.Locations) != 2 {
+		t.Fatalf("got %d locations, want 2", len(s.Locations))
+	}
+	root := s.Locations[0]
+	if root.Pattern != "/" || root.ProxyPass != "http://backend" || len(root.Locations) != 1 {
+		t.Errorf("unexpected location: %+v", root)
+	}
+	if nested := root.Locations[0]; nested.Modifier != nginx.LocationRegexCaseless || nested.Pattern != `\.png$` {
+		t.Errorf("unexpected nested location: %+v", nested)
+	}
+	if named := s.Locations[1]; !named.Named || named.Pattern != "@fallback" {
+		t.Errorf("unexpected named location: %+v", named)
+	}
+	if line := root.Directive.Node.Span().Start.Line; line != 21 {
+		t.Errorf("got location at line %d, want 21", line)
+	}
+
+	if st := c.Stream; st == nil || len(st.Servers) != 1 || st.Upstream("dns") == nil {
+		t.Errorf("unexpected stream: %+v", st)
+	} else if srv := st.Servers[0]; !reflect.DeepEqual(srv.Listens, []string{"53"}) || srv.ProxyPass != "dns" {
+		t.Errorf("unexpected stream server: %+v", srv)
+	}
+
+	if m := c.Mail; m == nil || len(m.Servers) != 1 {
+		t.Errorf("unexpected mail: %+v", m)
+	} else if srv := m.Servers[0]; srv.Protocol != "imap" || srv.Names[0] != "mail.example.com" || srv.Listens[0] != "143" {
+		t.Errorf("unexpected mail server: %+v", srv)
+	}
+}
+
+func TestUpstream(t *testing.T) {
+	c, err := newConfig(t, modelConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u := c.HTTP.Upstream("backend")
+	if u == nil {
+		t.Fatal("upstream backend not found")
+	}
+	if u.Method != "least_conn" || len(u.MethodArgs) != 0 || u.Keepalive != 32 {
+		t.Errorf("unexpected upstream: %+v", u)
+	}
+	var servers []UpstreamServer
+	for _, s := range u.Servers {
+		if s.Directive.Name != "server" {
+			t.Errorf("%s: unexpected directive %q", s.Address, s.Directive.Name)
+		}
+		c := *s
+		c.Directive = nil
+		servers = append(servers, c)
+	}
+	expected := []UpstreamServer{
+		{Address: "10.0.0.1:8080", Weight: 5, MaxFails: 3, FailTimeout: "30s"},
+		{Address: "10.0.0.2:8080", Weight: 1, MaxFails: 1, FailTimeout: "10s", MaxConns: 100, Backup: true},
+		{Address: "unix:/run/app.sock", Weight: 1, MaxFails: 1, FailTimeout: "10s", Down: true},
+	}
+	if !reflect.DeepEqual(servers, expected) {
+		t.Errorf("got servers\n%+v\nwant\n%+v", servers, expected)
+	}
+
+	h := c.HTTP.Upstream("hashed")
+	if h.Method != "hash" || !reflect.DeepEqual(h.MethodArgs, []string{"$request_uri", "consistent"}) {
+		t.Errorf("unexpected method %q %q", h.Method, h.MethodArgs)
+	}
+	if !h.Servers[0].Resolve {
+		t.Errorf("the resolve parameter is missing")
+	}
+	if c.HTTP.Upstream("missing") != nil {
+		t.Errorf("unexpected upstream")
+	}
+	if u := c.Stream.Upstream("dns"); u.Method != "round_robin" || u.Servers[0].Address != "10.0.0.53:53" {
+		t.Errorf("unexpected stream upstream: %+v", u)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	var tests = []struct {
+		input string
+		err   string
+	}{
+		{"server a weight=0;", `test:3:9: invalid parameter "weight=0"`},
+		{"server a max_fails=x;", `test:3:9: invalid parameter "max_fails=x"`},
+		{"server a weight;", `test:3:9: invalid parameter "weight"`},
+		{"server a slow;", `test:3:9: invalid parameter "slow"`},
+		{"keepalive -1;", `test:3:9: invalid number "-1" in "keepalive" directive`},
+	}
+	for _, test := range tests {
+		_, err := newConfig(t, "http {\n    upstream u {\n        "+test.input+"\n    }\n}\n")
+		if err == nil {
+			t.Errorf("%q: expected an error", test.input)
+			continue
+		}
+		if err.Error() != test.err {
+			t.Errorf("%q: got error %q, want %q", test.input, err, test.err)
+		}
+	}
+}
+
+func TestNewFromJSON(t *testing.T) {
+	tree, err := nginx.Parse("test", modelConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := nginx.NewConfiguration(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded nginx.Configuration
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := New(&decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := c.HTTP.Servers[0]
+	if len(s.Listens) != 1 || s.Listens[0].Port != 443 || !s.Listens[0].SSL || !s.Listens[0].DefaultServer {
+		t.Errorf("unexpected listen: %+v", s.Listens)
+	}
+	if root := s.Locations[0]; root.Pattern != "/" || root.Locations[0].Modifier != nginx.LocationRegexCaseless {
+		t.Errorf("unexpected location: %+v", root)
+	}
+	if named := s.Locations[1]; !named.Named || named.Pattern != "@fallback" {
+		t.Errorf("unexpected named location: %+v", named)
+	}
+
+	decoded.Directives[1].Block[2].Block[0].Args = []string{"a:x"}
+	if _, err := New(&decoded); err == nil || err.Error() != `invalid port in "a:x"` {
+		t.Errorf("got error %v, want %q", err, `invalid port in "a:x"`)
+	}
+}
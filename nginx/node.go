@@ -0,0 +1,492 @@
+// Some of this code is from https://go.dev/src/text/template/parse/
+
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nginx
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NodeType identifies the type of a parse tree node.
+type NodeType int
+
+// Type returns itself and provides an easy default implementation for
+// embedding in a Node.
+func (t NodeType) Type() NodeType {
+	return t
+}
+
+func (t NodeType) String() string {
+	return nodeTypes[t]
+}
+
+const (
+	NodeText      NodeType = iota
+	NodeComment            // A comment.
+	NodeList               // A list of Nodes
+	NodeDirective          // An nginx configuration directive
+	NodeBlock              // A configuration block
+	NodeArgument           // An argument for a directive
+	NodeEmptyLine          // An empty line; will be used for formatting
+	NodeLua                // A node containing Lua code
+)
+
+var nodeTypes = map[NodeType]string{
+	NodeText:      "NodeText",
+	NodeComment:   "NodeComment",
+	NodeList:      "NodeList",
+	NodeDirective: "NodeDirective",
+	NodeBlock:     "NodeBlock",
+	NodeArgument:  "NodeArgument",
+	NodeEmptyLine: "NodeEmptyLine",
+	NodeLua:       "NodeLua",
+}
+
+// Pos represents a byte position in the original input text from which this
+// file was parsed.
+type Pos int
+
+func (p Pos) Position() Pos {
+	return p
+}
+
+// Position describes a location in a file, in a form that is useful to humans.
+type Position struct {
+	Filename string
+	Offset   int // byte offset, starting at 0.
+	Line     int // line number, starting at 1.
+	Column   int // column number in bytes, starting at 1.
+}
+
+// IsValid reports whether the position is valid.
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+func (p Position) String() string {
+	if !p.IsValid() {
+		return p.Filename
+	}
+	return fmt.Sprintf("%s:%d:%d", p.Filename, p.Line, p.Column)
+}
+
+// Span is the range of text occupied by a node; End is the position just past the
+// last byte of the node.
+type Span struct {
+	Start Position
+	End   Position
+}
+
+// A Node is an element in the parse tree.
+type Node interface {
+	Type() NodeType
+	String() string
+	Copy() Node
+	Position() Pos // byte position of the start of the node.
+	End() Pos      // byte position just past the end of the node.
+	Span() Span    // start and end of the node, with line and column numbers.
+	tree() *Tree   // unexported so that only local types can satisfy it.
+}
+
+// end records where a node ends in the original text; it's embedded in all the nodes.
+type end Pos
+
+func (e end) End() Pos {
+	return Pos(e)
+}
+
+// span returns the span of a node of the tree t, starting at pos and ending at e.
+func (t *Tree) span(pos Pos, e end) Span {
+	if t == nil {
+		return Span{}
+	}
+	return Span{Start: t.Position(pos), End: t.Position(Pos(e))}
+}
+
+// ListNode holds a sequence of nodes.
+type ListNode struct {
+	NodeType
+	Pos
+	end
+	tr    *Tree
+	Nodes []Node
+	orig  []Node // the nodes as they were parsed; used by the printer.
+}
+
+func (t *Tree) newList(pos Pos) *ListNode {
+	return &ListNode{tr: t, NodeType: NodeList, Pos: pos}
+}
+
+func (l *ListNode) append(n Node) {
+	l.Nodes = append(l.Nodes, n)
+}
+
+func (l *ListNode) tree() *Tree {
+	return l.tr
+}
+
+func (l *ListNode) Span() Span {
+	return l.tr.span(l.Pos, l.end)
+}
+
+func (l *ListNode) String() string {
+	return ""
+}
+
+func (l *ListNode) CopyList() *ListNode {
+	if l == nil {
+		return l
+	}
+	n := l.tr.newList(l.Pos)
+	n.end = l.end
+	for _, elem := range l.Nodes {
+		n.append(elem.Copy())
+	}
+	// see DirectiveNode.Copy.
+	n.orig = l.orig
+	if sameNodes(l.Nodes, l.orig) {
+		n.orig = append([]Node(nil), n.Nodes...)
+	}
+	return n
+}
+
+func (l *ListNode) Copy() Node {
+	return l.CopyList()
+}
+
+// CommentNode holds a comment.
+type CommentNode struct {
+	NodeType
+	Pos
+	end
+	tr   *Tree
+	Text string
+}
+
+// newComment creates a comment node; pos is the position of the '#' character.
+func (t *Tree) newComment(pos Pos, text string) *CommentNode {
+	return &CommentNode{tr: t, NodeType: NodeComment, Pos: pos, end: end(pos + 1 + Pos(len(text))), Text: text}
+}
+
+func (c *CommentNode) String() string {
+	return fmt.Sprintf("#%s", c.Text)
+}
+
+func (c *CommentNode) tree() *Tree {
+	return c.tr
+}
+
+func (c *CommentNode) Span() Span {
+	return c.tr.span(c.Pos, c.end)
+}
+
+func (c *CommentNode) Copy() Node {
+	return &CommentNode{tr: c.tr, NodeType: NodeComment, Pos: c.Pos, end: c.end, Text: c.Text}
+}
+
+// DirectiveNode contains a directive and is linked to its arguments, including an optional block.
+//
+// The comments describing a directive are attached to it: Leading holds the comments on the
+// lines right above the directive, Trailing the comment following it on its last line, and
+// Inline the comments found between its arguments. The other comments are part of the list
+// containing the directive.
+type DirectiveNode struct {
+	NodeType
+	Pos
+	end
+	tr       *Tree
+	Text     string
+	Args     []Node // Arguments, which can include a "Block"
+	Leading  []*CommentNode
+	Trailing *CommentNode
+	Inline   []*CommentNode
+	// the directive as it was parsed; used by the printer.
+	origText     string
+	origArgs     []Node
+	origLeading  []*CommentNode
+	origTrailing *CommentNode
+	origInline   []*CommentNode
+}
+
+func (t *Tree) newDirective(pos Pos, text string) *DirectiveNode {
+	return &DirectiveNode{tr: t, NodeType: NodeDirective, Pos: pos, end: end(pos + Pos(len(text))), Text: text}
+}
+
+func (d *DirectiveNode) String() string {
+	return d.Text
+}
+
+func (d *DirectiveNode) tree() *Tree {
+	return d.tr
+}
+
+func (d *DirectiveNode) Span() Span {
+	return d.tr.span(d.Pos, d.end)
+}
+
+func (d *DirectiveNode) Copy() Node {
+	n := &DirectiveNode{tr: d.tr, NodeType: NodeDirective, Pos: d.Pos, end: d.end, Text: d.Text, origText: d.origText}
+	for _, arg := range d.Args {
+		n.Args = append(n.Args, arg.Copy())
+	}
+	n.Leading = copyComments(d.Leading)
+	n.Inline = copyComments(d.Inline)
+	if d.Trailing != nil {
+		n.Trailing = d.Trailing.Copy().(*CommentNode)
+	}
+
+	// the original state refers to the copies when nothing was changed; otherwise it keeps
+	// referring to the original nodes, which are only needed for their positions.
+	n.origArgs, n.origLeading, n.origInline, n.origTrailing = d.origArgs, d.origLeading, d.origInline, d.origTrailing
+	if sameNodes(d.Args, d.origArgs) {
+		n.origArgs = append([]Node(nil), n.Args...)
+	}
+	if sameComments(d.Leading, d.origLeading) {
+		n.origLeading = append([]*CommentNode(nil), n.Leading...)
+	}
+	if sameComments(d.Inline, d.origInline) {
+		n.origInline = append([]*CommentNode(nil), n.Inline...)
+	}
+	if d.Trailing != nil && d.Trailing == d.origTrailing {
+		n.origTrailing = n.Trailing
+	}
+	return n
+}
+
+func (d *DirectiveNode) append(arg Node) {
+	d.Args = append(d.Args, arg)
+}
+
+// ArgumentNode contains one argument (string) for a directive. Value is its decoded value,
+// without the quotes and with the escape sequences replaced, and Quote is the quote style of
+// the argument as written in the configuration, which Raw returns. Value and Quote are the
+// only fields to change: when they differ from the parsed ones, the argument is written by
+// quoting Value again, as needed to be read back as the same value; otherwise the raw text is
+// written.
+type ArgumentNode struct {
+	NodeType
+	Pos
+	end
+	tr    *Tree
+	Value string
+	Quote QuoteStyle
+	text  string     // the argument as written in the configuration.
+	value string     // Value, when the argument was created from text.
+	quote QuoteStyle // Quote, when the argument was created from text.
+}
+
+func (t *Tree) newArgument(pos Pos, text string) *ArgumentNode {
+	a := &ArgumentNode{tr: t, NodeType: NodeArgument, Pos: pos, end: end(pos + Pos(len(text))), text: text}
+	a.Value, a.Quote = unquoteArg(text)
+	a.value, a.quote = a.Value, a.Quote
+	return a
+}
+
+// Raw returns the argument as it was written in the configuration, with its quotes and
+// escape sequences, regardless of the changes to Value and Quote.
+func (a *ArgumentNode) Raw() string {
+	return a.text
+}
+
+// String returns the argument as it's written: the raw text, or Value quoted again when
+// Value or Quote have been changed.
+func (a *ArgumentNode) String() string {
+	if a.Value != a.value || a.Quote != a.quote {
+		return quoteArg(a.Value, a.Quote)
+	}
+	return a.text
+}
+
+func (a *ArgumentNode) tree() *Tree {
+	return a.tr
+}
+
+func (a *ArgumentNode) Span() Span {
+	return a.tr.span(a.Pos, a.end)
+}
+
+func (a *ArgumentNode) Copy() Node {
+	c := *a
+	return &c
+}
+
+type EmptyLineNode struct {
+	NodeType
+	Pos
+	end
+	tr *Tree
+}
+
+// newEmptyLine creates an empty line node spanning the newlines between pos and e.
+func (t *Tree) newEmptyLine(pos Pos, e Pos) *EmptyLineNode {
+	return &EmptyLineNode{tr: t, NodeType: NodeEmptyLine, Pos: pos, end: end(e)}
+}
+
+func (e *EmptyLineNode) String() string {
+	return "\n"
+}
+
+func (e *EmptyLineNode) tree() *Tree {
+	return e.tr
+}
+
+func (e *EmptyLineNode) Span() Span {
+	return e.tr.span(e.Pos, e.end)
+}
+
+func (e *EmptyLineNode) Copy() Node {
+	return &EmptyLineNode{tr: e.tr, NodeType: NodeEmptyLine, Pos: e.Pos, end: e.end}
+}
+
+type BlockNode struct {
+	NodeType
+	Pos
+	end
+	tr   *Tree
+	List *ListNode // The list of nodes in this block
+}
+
+func (t *Tree) newBlock(pos Pos) *BlockNode {
+	l := t.newList(pos)
+	return &BlockNode{tr: t, NodeType: NodeBlock, Pos: pos, List: l}
+}
+
+func (b *BlockNode) String() string {
+	return ""
+}
+
+func (b *BlockNode) tree() *Tree {
+	return b.tr
+}
+
+func (b *BlockNode) Span() Span {
+	return b.tr.span(b.Pos, b.end)
+}
+
+func (b *BlockNode) Copy() Node {
+	n := &BlockNode{tr: b.tr, NodeType: NodeBlock, Pos: b.Pos, end: b.end, List: b.List.CopyList()}
+	return n
+}
+
+func (b *BlockNode) append(node Node) {
+	b.List.append(node)
+}
+
+// BlockLua is the block of a *_by_lua_block directive, which contains Lua code instead of
+// directives. Lines holds the code between the brackets split at the newlines, so that joining
+// the lines with "\n" gives back the exact code; the first line is usually empty, and the last
+// one contains the indentation of the closing bracket.
+type BlockLua struct {
+	NodeType
+	Pos
+	end
+	tr      *Tree
+	Lines   []string
+	codeEnd end      // where the code ends, before the closing bracket.
+	orig    []string // the lines as they were parsed; used by the printer.
+}
+
+func (t *Tree) newBlockLua(pos Pos) *BlockLua {
+	return &BlockLua{tr: t, NodeType: NodeLua, Pos: pos}
+}
+
+func (bl *BlockLua) String() string {
+	return ""
+}
+
+func (bl *BlockLua) tree() *Tree {
+	return bl.tr
+}
+
+func (bl *BlockLua) Span() Span {
+	return bl.tr.span(bl.Pos, bl.end)
+}
+
+// Code returns the Lua code in the block.
+func (bl *BlockLua) Code() string {
+	return strings.Join(bl.Lines, "\n")
+}
+
+// CodeSpan returns the span of the Lua code in the original text, between the brackets.
+func (bl *BlockLua) CodeSpan() Span {
+	return bl.tr.span(bl.Pos+1, bl.codeEnd)
+}
+
+func (bl *BlockLua) Copy() Node {
+	n := &BlockLua{tr: bl.tr, NodeType: NodeLua, Pos: bl.Pos, end: bl.end, Lines: make([]string, len(bl.Lines)), codeEnd: bl.codeEnd, orig: bl.orig}
+	copy(n.Lines, bl.Lines)
+	return n
+}
+
+// NewDirective creates a directive node with the given name and argument values; it can be
+// added to a tree that will then be written with Tree.WriteTo. The arguments are quoted when
+// needed.
+func NewDirective(name string, args ...string) *DirectiveNode {
+	d := &DirectiveNode{NodeType: NodeDirective, Text: name}
+	for _, arg := range args {
+		d.append(NewArgument(arg))
+	}
+	return d
+}
+
+// NewArgument creates an argument node with the given value, quoted when needed.
+func NewArgument(value string) *ArgumentNode {
+	text := quoteArg(value, QuoteNone)
+	_, quote := unquoteArg(text)
+	return &ArgumentNode{NodeType: NodeArgument, text: text, Value: value, Quote: quote, value: value, quote: quote}
+}
+
+// NewBlock creates a block containing nodes; a block can be added as the last argument of a
+// directive.
+func NewBlock(nodes ...Node) *BlockNode {
+	return &BlockNode{NodeType: NodeBlock, List: &ListNode{NodeType: NodeList, Nodes: nodes}}
+}
+
+// NewComment creates a comment node; text doesn't include the leading '#'.
+func NewComment(text string) *CommentNode {
+	return &CommentNode{NodeType: NodeComment, Text: text}
+}
+
+// NewEmptyLine creates an empty line node.
+func NewEmptyLine() *EmptyLineNode {
+	return &EmptyLineNode{NodeType: NodeEmptyLine}
+}
+
+// copyComments returns a deep copy of a list of comments.
+func copyComments(cs []*CommentNode) []*CommentNode {
+	var res []*CommentNode
+	for _, c := range cs {
+		res = append(res, c.Copy().(*CommentNode))
+	}
+	return res
+}
+
+// sameComments reports whether a and b contain the same comments, in the same order.
+func sameComments(a, b []*CommentNode) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sameNodes reports whether a and b contain the same nodes, in the same order.
+func sameNodes(a, b []Node) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
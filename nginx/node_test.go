@@ -0,0 +1,94 @@
+package nginx
+
+import (
+	"testing"
+)
+
+func TestNodeSpans(t *testing.T) {
+	input := `# main
+http {
+
+    server {
+        proxy_set_header Host $host;
+    }
+}
+`
+	tree, err := Parse("test.conf", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	http := tree.Root.Nodes[0].(*DirectiveNode)
+	comment := http.Leading[0]
+	block := http.Args[0].(*BlockNode)
+	emptyLine := block.List.Nodes[0].(*EmptyLineNode)
+	server := block.List.Nodes[1].(*DirectiveNode)
+	psh := server.Args[0].(*BlockNode).List.Nodes[0].(*DirectiveNode)
+	arg := psh.Args[1].(*ArgumentNode)
+
+	tests := []struct {
+		name string
+		node Node
+		text string
+		span Span
+	}{
+		{"comment", comment, "# main", Span{
+			Start: Position{"test.conf", 0, 1, 1},
+			End:   Position{"test.conf", 6, 1, 7},
+		}},
+		{"http", http, input[7 : len(input)-1], Span{
+			Start: Position{"test.conf", 7, 2, 1},
+			End:   Position{"test.conf", 72, 7, 2},
+		}},
+		{"block", block, input[12 : len(input)-1], Span{
+			Start: Position{"test.conf", 12, 2, 6},
+			End:   Position{"test.conf", 72, 7, 2},
+		}},
+		{"empty line", emptyLine, "\n\n", Span{
+			Start: Position{"test.conf", 13, 2, 7},
+			End:   Position{"test.conf", 15, 4, 1},
+		}},
+		{"directive", psh, "proxy_set_header Host $host;", Span{
+			Start: Position{"test.conf", 36, 5, 9},
+			End:   Position{"test.conf", 64, 5, 37},
+		}},
+		{"second argument", arg, "$host", Span{
+			Start: Position{"test.conf", 58, 5, 31},
+			End:   Position{"test.conf", 63, 5, 36},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := input[tt.node.Position():tt.node.End()]; got != tt.text {
+				t.Errorf("expected text %q, got %q", tt.text, got)
+			}
+			if got := tt.node.Span(); got != tt.span {
+				t.Errorf("expected span %+v, got %+v", tt.span, got)
+			}
+		})
+	}
+}
+
+func TestTreePosition(t *testing.T) {
+	tree, err := Parse("test.conf", "events {\n}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		pos      Pos
+		expected string
+	}{
+		{0, "test.conf:1:1"},
+		{7, "test.conf:1:8"},
+		{8, "test.conf:1:9"},
+		{9, "test.conf:2:1"},
+		{11, "test.conf:3:1"},
+	}
+	for _, tt := range tests {
+		if got := tree.Position(tt.pos).String(); got != tt.expected {
+			t.Errorf("position of %d: expected %s, got %s", tt.pos, tt.expected, got)
+		}
+	}
+}
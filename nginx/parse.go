@@ -0,0 +1,646 @@
+// Some of this code is from https://go.dev/src/text/template/parse/
+
+// Copyright 2011 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package nginx
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+// A Mode value is a set of flags (or 0). They control optional parser behaviour.
+type Mode uint
+
+const (
+	SkipContextCheck Mode = 1 << iota // don't check that directives appear in a context where nginx allows them
+	AllErrors                         // report all errors, not just the first one
+)
+
+var skipValidation map[string]bool
+
+func init() {
+	skipValidation = make(map[string]bool)
+	for _, dirname := range []string{"map", "split_clients", "geo", "types", "match"} {
+		skipValidation[dirname] = true
+	}
+}
+
+// Tree is the representation of a single parsed file.
+type Tree struct {
+	Filename string    // name of the file represented by this tree.
+	Root     *ListNode // top-level root of the tree.
+	Mode     Mode      // parsing mode.
+	text     string    // text parsed to create this Tree.
+	errors   ErrorList // errors found while parsing, in the AllErrors mode.
+	warnings ErrorList // warnings found while parsing.
+	lines    []int     // offsets of the start of each line, built on demand.
+	scanned  int       // length of the text scanned to build lines.
+	inc      *includes // resolves include directives; nil when includes are not resolved.
+	// Parsing only; cleared after parse.
+	lex       *lexer
+	token     [3]item // three-token lookahead for parser.
+	peekCount int
+}
+
+func (t *Tree) startParse(lex *lexer) {
+	t.Root = nil
+	t.lex = lex
+	t.errors = nil
+	t.warnings = nil
+	t.text = lex.input
+	t.lines = nil
+}
+
+func (t *Tree) stopParse() {
+	if t.lex != nil {
+		// the lexer may have read the text from a reader.
+		t.text = t.lex.input
+	}
+	t.lex = nil
+}
+
+// New allocates a new parse tree with the given name.
+func New(name string) *Tree {
+	return &Tree{
+		Filename: name,
+	}
+}
+
+// Parse parses the configuration text and fills the tree; the returned tree is t itself,
+// which makes it possible to write New(name).Parse(text).
+//
+// Parsing stops at the first error, unless the AllErrors mode is set: in that case the parser
+// skips the invalid parts of the text and returns the tree built from the rest, together with
+// an ErrorList containing all the errors. Warnings, which describe valid configurations that
+// this package can't fully handle, don't make parsing fail: Warnings returns them.
+func (t *Tree) Parse(text string) (tree *Tree, err error) {
+	return t.parseFrom(lex(t.Filename, text))
+}
+
+// ParseReader is like Parse, but it reads the configuration from r, which is wrapped in a
+// bufio.Reader if needed; parsing starts before the whole configuration has been read. An
+// error reading from r is returned instead of the parse errors.
+func (t *Tree) ParseReader(r io.Reader) (*Tree, error) {
+	l := lexReader(t.Filename, r)
+	tree, err := t.parseFrom(l)
+	if l.err != nil {
+		return nil, l.err
+	}
+	return tree, err
+}
+
+// parseFrom parses the items produced by the lexer l.
+func (t *Tree) parseFrom(l *lexer) (tree *Tree, err error) {
+	defer t.recover(&err)
+
+	t.startParse(l)
+	ctx := newCtx()
+	ctx.Push("root")
+	t.parse(ctx, true)
+	t.stopParse()
+	snapshot(t.Root)
+
+	t.warnings.sort()
+	if t.Mode&AllErrors != 0 && len(t.errors) > 0 {
+		t.errors.sort()
+		return t, t.errors
+	}
+	return t, nil
+}
+
+// Warnings returns the warnings found by the last parse, sorted by position, in every mode; for
+// instance, a location whose regular expression can't be converted to the RE2 syntax.
+func (t *Tree) Warnings() ErrorList {
+	return t.warnings
+}
+
+// parse parses the top level of a file; ctx is the context of the file, which is not the
+// main context when the file is included by another one.
+func (t *Tree) parse(ctx *context, validate bool) {
+	t.Root = t.newList(t.peek().pos)
+
+	for t.peek().typ != itemEOF {
+		switch p := t.peek(); p.typ {
+		case itemWord, itemString:
+			t.appendDirective(t.Root, ctx, validate)
+		case itemNewline:
+			if node := t.parseEmptyLines(); node != nil {
+				t.Root.append(node)
+			}
+		case itemComment:
+			item := t.next()
+			t.Root.append(t.newComment(item.pos-1, item.val))
+		default:
+			t.unexpected(ctx)
+		}
+	}
+	t.Root.end = end(t.peek().pos)
+	attachComments(t.Root)
+}
+
+// unexpected reports an item that can't start a directive and skips it; a block is skipped
+// entirely, so that parsing can resume after its closing bracket.
+func (t *Tree) unexpected(ctx *context) {
+	p := t.peek()
+	switch p.typ {
+	case itemError:
+		t.next()
+		t.errorf(p, KindLex, "%s", p.val)
+	case itemLeftBlock:
+		t.errorf(p, KindSyntax, "unexpected %s", p)
+		t.parseBlock(ctx, false)
+	default:
+		t.next()
+		t.errorf(p, KindSyntax, "unexpected %s", p)
+	}
+}
+
+// appendDirective parses a directive and appends it to list; when includes are resolved,
+// an include directive is replaced by the contents of the included files.
+func (t *Tree) appendDirective(list *ListNode, ctx *context, validate bool) {
+	node := t.parseDirective(ctx, validate)
+	if d, ok := node.(*DirectiveNode); ok && t.inc != nil && d.Text == "include" {
+		list.Nodes = append(list.Nodes, t.include(d, ctx, validate)...)
+		return
+	}
+	list.append(node)
+}
+
+func (t *Tree) parseDirective(ctx *context, validate bool) Node {
+	item := t.next()
+	dirName := item.val
+
+	masks, ok := dirMask[dirName]
+	if validate && !ok {
+		t.errorf(item, KindUnknownDirective, "unknown directive %q", dirName)
+	}
+
+	if validate && ok && t.Mode&SkipContextCheck == 0 && !t.validateDirectiveContext(dirName, masks, ctx) {
+		t.errorf(item, KindContext, "directive %q is not allowed in %s", dirName, confContextName(ctx.curContext()))
+	}
+
+	n := t.newDirective(item.pos, item.val)
+
+Loop:
+	for {
+		p := t.peek()
+		switch p.typ {
+		case itemWord, itemString:
+			item := t.next()
+			arg := t.newArgument(item.pos, item.val)
+			n.append(arg)
+			n.end = arg.end
+		case itemLeftBlock:
+			var block Node
+
+			if ctx.IsContext(dirName) {
+				ctx.Push(dirName)
+			}
+
+			// some directives must skip validation because they contain "freeform" text or Lua code.
+			if _, ok := skipValidation[dirName]; ok {
+				block = t.parseBlock(ctx, false)
+			} else if isLuaBlock(dirName) {
+				block = t.parseLua()
+			} else {
+				block = t.parseBlock(ctx, validate)
+			}
+			n.append(block)
+			n.end = end(block.End())
+
+			if ctx.IsContext(dirName) {
+				ctx.Pop(dirName)
+			}
+
+			// A block always terminate a directive!
+			break Loop
+		case itemTerminator:
+			term := t.next()
+			n.end = end(term.pos + 1)
+			break Loop
+		case itemNewline:
+			// XXX newlines found while scanning a directive should be safe to ignore.
+			t.next()
+		case itemComment:
+			t.next()
+			n.Inline = append(n.Inline, t.newComment(p.pos-1, p.val))
+		case itemError:
+			t.next()
+			t.errorf(p, KindLex, "%s", p.val)
+		default:
+			// the closing bracket (or EOF) is not consumed, so that the enclosing block can end.
+			t.errorf(p, KindSyntax, "unexpected %s, expecting \";\" or \"{\"", p)
+			break Loop
+		}
+	}
+
+	// now that we have built the full node we can perform some validation,
+	// like checking the number of expected arguments and the presence of blocks.
+	if !validate {
+		return n
+	}
+
+	var args []string
+	var hasBlock bool
+	for _, a := range n.Args {
+		switch arg := a.(type) {
+		case *ArgumentNode:
+			args = append(args, arg.Value)
+		case *BlockNode, *BlockLua:
+			hasBlock = true
+		}
+	}
+
+	if t.Mode&SkipContextCheck == 0 {
+		masks = contextMasks(masks, ctx)
+	}
+	if err := checkArguments(dirName, masks, args, hasBlock); err != nil {
+		t.errorf(item, KindArguments, "%s", err)
+	} else if err := checkStructured(n); err != nil {
+		t.report(err)
+	}
+
+	return n
+}
+
+// validateDirectiveContext checks if a directive belongs to the right context; for example
+// a "location" can't be in the "http" context.
+func (t *Tree) validateDirectiveContext(name string, masks []int, ctx *context) bool {
+	for _, mask := range masks {
+		if mask&ctx.curContext() > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contextMasks returns the masks that are valid in the current context.
+func contextMasks(masks []int, ctx *context) []int {
+	var result []int
+	for _, mask := range masks {
+		if mask&ctx.curContext() > 0 {
+			result = append(result, mask)
+		}
+	}
+	return result
+}
+
+// checkArguments checks the arguments of a directive against its masks, the same way nginx does
+// in ngx_conf_handler(); the directive is valid when at least one of the masks is satisfied.
+func checkArguments(name string, masks []int, args []string, hasBlock bool) error {
+	if len(masks) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, mask := range masks {
+		if err = checkMask(name, mask, args, hasBlock); err == nil {
+			return nil
+		}
+	}
+
+	// with multiple masks it's more useful to report all the accepted forms.
+	if len(masks) > 1 {
+		var expected []string
+		seen := make(map[string]bool)
+		for _, mask := range masks {
+			if name := argMaskName(mask); !seen[name] {
+				seen[name] = true
+				expected = append(expected, name)
+			}
+		}
+		return fmt.Errorf("invalid arguments in %q directive, expected %s", name, strings.Join(expected, " or "))
+	}
+	return err
+}
+
+// checkMask checks the arguments of a directive against a single mask.
+func checkMask(name string, mask int, args []string, hasBlock bool) error {
+	if mask&ngx_CONF_BLOCK != 0 && !hasBlock {
+		return fmt.Errorf("directive %q has no opening \"{\"", name)
+	}
+	if mask&ngx_CONF_BLOCK == 0 && hasBlock {
+		return fmt.Errorf("directive %q is not terminated by \";\"", name)
+	}
+
+	nargs := len(args)
+	switch {
+	case mask&ngx_CONF_ANY != 0:
+		return nil
+	case mask&ngx_CONF_FLAG != 0:
+		if nargs != 1 {
+			break
+		}
+		if !strings.EqualFold(args[0], "on") && !strings.EqualFold(args[0], "off") {
+			return fmt.Errorf("invalid value %q in %q directive, it must be \"on\" or \"off\"", args[0], name)
+		}
+		return nil
+	case mask&ngx_CONF_1MORE != 0:
+		if nargs >= 1 {
+			return nil
+		}
+	case mask&ngx_CONF_2MORE != 0:
+		if nargs >= 2 {
+			return nil
+		}
+	case nargs < len(argumentNumber) && mask&argumentNumber[nargs] != 0:
+		return nil
+	}
+
+	return fmt.Errorf("invalid number of arguments in %q directive, expected %s", name, argMaskName(mask))
+}
+
+// Position returns the file, line and column of the byte position pos in the text of the tree.
+func (t *Tree) Position(pos Pos) Position {
+	line, col := t.position(pos)
+	return Position{Filename: t.Filename, Offset: int(pos), Line: line, Column: col}
+}
+
+// position returns the line and column (both starting at 1) of the byte position pos.
+func (t *Tree) position(pos Pos) (line, col int) {
+	if t.lex != nil {
+		// the lexer may still be reading the text.
+		t.text = t.lex.input
+	}
+	if t.lines == nil {
+		t.lines, t.scanned = []int{0}, 0
+	}
+	for ; t.scanned < len(t.text); t.scanned++ {
+		if t.text[t.scanned] == '\n' {
+			t.lines = append(t.lines, t.scanned+1)
+		}
+	}
+	// index of the first line starting after pos.
+	i := sort.SearchInts(t.lines, int(pos)+1)
+	return i, int(pos) - t.lines[i-1] + 1
+}
+
+// parseEmptyLines parse one or more newlines; it only emits a EmptyLineNode
+// when one or more _empty lines_ are found.
+// The general idea is that we don't care about newlines, but we care to keep
+// empty lines (multiple empty lines compressed into one) so that we can format the
+// tree later.
+func (t *Tree) parseEmptyLines() Node {
+	var res *EmptyLineNode
+	this := t.next()
+
+	for t.peek().typ == itemNewline {
+		// discard all following newlines, but ensure we return at least one.
+		nl := t.next()
+		if res == nil {
+			res = t.newEmptyLine(this.pos, nl.pos+1)
+		}
+		res.end = end(nl.pos + 1)
+	}
+
+	if res == nil {
+		return nil
+	}
+	return res
+}
+
+// attachComments moves the comments describing a directive from the list to the directive:
+// a comment on the same line as the end of a directive becomes its trailing comment, and the
+// comments right above a directive, without empty lines in between, become its leading
+// comments. Comments coming from a different file than the directive are never attached.
+func attachComments(l *ListNode) {
+	var nodes []Node
+	var pending []*CommentNode // comments that may be leading comments of the next directive.
+	flush := func() {
+		for _, c := range pending {
+			nodes = append(nodes, c)
+		}
+		pending = nil
+	}
+
+	for _, n := range l.Nodes {
+		switch n := n.(type) {
+		case *CommentNode:
+			if len(pending) == 0 && len(nodes) > 0 {
+				d, ok := nodes[len(nodes)-1].(*DirectiveNode)
+				if ok && d.Trailing == nil && d.tr == n.tr && d.tr.Position(d.End()).Line == n.tr.Position(n.Pos).Line {
+					d.Trailing = n
+					continue
+				}
+			}
+			if len(pending) > 0 && pending[0].tr != n.tr {
+				flush()
+			}
+			pending = append(pending, n)
+		case *DirectiveNode:
+			if len(pending) > 0 && pending[0].tr == n.tr {
+				n.Leading = append(pending, n.Leading...)
+				pending = nil
+			}
+			flush()
+			nodes = append(nodes, n)
+		default:
+			flush()
+			nodes = append(nodes, n)
+		}
+	}
+	flush()
+	l.Nodes = nodes
+}
+
+// this is awfully similar to the global parse() method...
+func (t *Tree) parseBlock(ctx *context, validate bool) Node {
+	blockStart := t.next()
+	block := t.newBlock(blockStart.pos)
+	block.List.Pos = blockStart.pos + 1
+	var last item // the token closing the block.
+
+Loop:
+	for {
+		n := t.peek()
+		switch n.typ {
+		case itemWord, itemString:
+			t.appendDirective(block.List, ctx, validate)
+		case itemNewline:
+			if node := t.parseEmptyLines(); node != nil {
+				block.append(node)
+			}
+		case itemComment:
+			item := t.next()
+			block.append(t.newComment(item.pos-1, item.val))
+		case itemRightBlock:
+			last = t.next()
+			break Loop
+		case itemEOF:
+			// the lexer has already reported the unclosed block.
+			last = n
+			break Loop
+		default:
+			t.unexpected(ctx)
+		}
+	}
+
+	// the list ends before the closing bracket, the block right after it.
+	block.List.end = end(last.pos)
+	block.end = end(last.pos + Pos(len(last.val)))
+	attachComments(block.List)
+
+	return block
+}
+
+// parseLua parses the block of a *_by_lua_block directive: the lexer returns the Lua code in
+// the block as a single item, which is kept verbatim.
+func (t *Tree) parseLua() Node {
+	blockStart := t.next()
+	block := t.newBlockLua(blockStart.pos)
+	block.codeEnd = end(blockStart.pos + 1)
+	// the invalid encodings in the code are reported before it.
+	for p := t.peek(); p.typ == itemError && p.val == errInvalidEncoding; p = t.peek() {
+		t.next()
+		t.errorf(p, KindLex, "%s", p.val)
+	}
+	if code := t.peek(); code.typ == itemLua {
+		t.next()
+		block.Lines = strings.Split(strings.ReplaceAll(code.val, "\r\n", "\n"), "\n")
+		block.codeEnd = end(code.pos + Pos(len(code.val)))
+	}
+	block.end = block.codeEnd
+
+	// the closing bracket follows the code, unless the lexer failed to scan it: in that case
+	// the rest of the block is skipped.
+	parensCounter := 1
+Loop:
+	for {
+		n := t.peek()
+		switch n.typ {
+		case itemLeftBlock:
+			parensCounter++
+			t.next()
+		case itemRightBlock:
+			parensCounter--
+			item := t.next()
+			block.end = end(item.pos + 1)
+			if parensCounter == 0 {
+				break Loop
+			}
+		case itemEOF:
+			break Loop
+		case itemError:
+			t.next()
+			t.errorf(n, KindLex, "%s", n.val)
+		default:
+			t.next()
+		}
+	}
+
+	return block
+}
+
+// peek returns but does not consume the next token.
+func (t *Tree) peek() item {
+	if t.peekCount > 0 {
+		return t.token[t.peekCount-1]
+	}
+	t.peekCount = 1
+	t.token[0] = t.lex.nextItem()
+	return t.token[0]
+}
+
+// next returns the next token.
+func (t *Tree) next() item {
+	if t.peekCount > 0 {
+		t.peekCount--
+	} else {
+		t.token[0] = t.lex.nextItem()
+	}
+	return t.token[t.peekCount]
+}
+
+// backup backs the input stream up one token.
+func (t *Tree) backup() {
+	t.peekCount++
+}
+
+// recover is the handler that turns panics into returns from the top level of Parse.
+func (t *Tree) recover(errp *error) {
+	e := recover()
+	if e != nil {
+		if _, ok := e.(runtime.Error); ok {
+			panic(e)
+		}
+		if t != nil {
+			t.stopParse()
+		}
+		*errp = e.(error)
+	}
+}
+
+// errorf reports an error about the token tok; unless the AllErrors mode is set, parsing
+// stops here.
+func (t *Tree) errorf(tok item, kind ErrorKind, format string, args ...interface{}) {
+	t.report(t.newError(tok, SeverityError, kind, format, args...))
+}
+
+// report reports an error; like errorf, it stops the parser unless AllErrors is set. Warnings
+// never stop the parser, and they are kept apart from the errors.
+func (t *Tree) report(err *ParseError) {
+	if err.Severity == SeverityWarning {
+		t.warnings = append(t.warnings, err)
+		return
+	}
+	if t.Mode&AllErrors == 0 {
+		panic(err)
+	}
+	t.errors = append(t.errors, err)
+}
+
+// newError creates a ParseError about tok. The offending text of lexer errors is taken
+// from the input, because their value is the error message.
+func (t *Tree) newError(tok item, severity Severity, kind ErrorKind, format string, args ...interface{}) *ParseError {
+	line, col := t.position(tok.pos)
+	token := tok.val
+	switch tok.typ {
+	case itemError:
+		token = tok.text
+	case itemEOF:
+		token = ""
+	}
+	return &ParseError{
+		Severity: severity,
+		Kind:     kind,
+		Filename: t.Filename,
+		Line:     line,
+		Column:   col,
+		Offset:   int(tok.pos),
+		Token:    token,
+		Msg:      fmt.Sprintf(format, args...),
+	}
+}
+
+// Parse creates a parse tree by lexing the contents of text.
+func Parse(name, text string) (*Tree, error) {
+	t, err := New(name).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// ParseReader creates a parse tree, using the given mode, by reading the configuration from r.
+// As with Tree.Parse, the tree is returned together with the errors when mode contains
+// AllErrors.
+func ParseReader(name string, r io.Reader, mode Mode) (*Tree, error) {
+	t := New(name)
+	t.Mode = mode
+	return t.ParseReader(r)
+}
+
+// ParseBytes is like ParseReader, but the configuration is in b.
+func ParseBytes(name string, b []byte, mode Mode) (*Tree, error) {
+	t := New(name)
+	t.Mode = mode
+	return t.Parse(string(b))
+}
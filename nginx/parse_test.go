@@ -0,0 +1,414 @@
+package nginx
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestContextValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"location at top level", "location / {}", `test:1:1: directive "location" is not allowed in NGX_MAIN_CONF`},
+		{"worker_connections in http", "http {\n  worker_connections 10;\n}", `test:2:3: directive "worker_connections" is not allowed in NGX_HTTP_MAIN_CONF`},
+		{"alias in server", "http { server {\n\talias /tmp; } }", `test:2:2: directive "alias" is not allowed in NGX_HTTP_SRV_CONF`},
+		{"alias in location", "http { server { location / { alias /tmp; } } }", ""},
+		{"nested locations", "http { server { location / { location /a { } alias /tmp; } } }", ""},
+		{"if in location", "http { server { location / { if ($a) { return 404; } } } }", ""},
+		{"events", "events { worker_connections 10; }", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse("test", tt.input)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatalf("unexpected error: %s", err)
+			case tt.err != "" && err == nil:
+				t.Fatalf("expected error %q, got none", tt.err)
+			case tt.err != "" && err.Error() != tt.err:
+				t.Fatalf("expected error %q, got %q", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestSkipContextCheck(t *testing.T) {
+	tree := New("snippet.conf")
+	tree.Mode = SkipContextCheck
+	if _, err := tree.Parse("server { listen 80; location / { root /srv; } }"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestArgumentsValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{"noargs", "http { server { location / { internal; } } }", ""},
+		{"noargs with args", "http { server { location / { internal yes; } } }", `test:1:30: invalid number of arguments in "internal" directive, expected NGX_CONF_NOARGS`},
+		{"take1", "pid /run/nginx.pid;", ""},
+		{"take1 with two args", "pid /run/nginx.pid /tmp/pid;", `test:1:1: invalid number of arguments in "pid" directive, expected NGX_CONF_TAKE1`},
+		{"take12", "error_log /var/log/error.log warn;", ""},
+		{"2more with one arg", "http { server { location / { try_files a; } } }", `test:1:30: invalid number of arguments in "try_files" directive, expected NGX_CONF_2MORE`},
+		{"take23", "http { add_header X-Foo bar always; }", ""},
+		{"take23 with one arg", "http { add_header X-Foo; }", `test:1:8: invalid number of arguments in "add_header" directive, expected NGX_CONF_TAKE2|NGX_CONF_TAKE3`},
+		{"flag", "http { sendfile on; tcp_nopush OFF; }", ""},
+		{"flag with invalid value", "http { sendfile yes; }", `test:1:8: invalid value "yes" in "sendfile" directive, it must be "on" or "off"`},
+		{"flag without value", "http { sendfile; }", `test:1:8: invalid number of arguments in "sendfile" directive, expected NGX_CONF_FLAG`},
+		{"1more", "http { index a b c d e f g h i; }", ""},
+		{"1more without args", "http { index; }", `test:1:8: invalid number of arguments in "index" directive, expected NGX_CONF_1MORE`},
+		{"block", "events { }", ""},
+		{"block without block", "events;", `test:1:1: directive "events" has no opening "{"`},
+		{"block with args", "events foo { }", `test:1:1: invalid number of arguments in "events" directive, expected NGX_CONF_NOARGS|NGX_CONF_BLOCK`},
+		{"unexpected block", "pid /tmp/pid { }", `test:1:1: directive "pid" is not terminated by ";"`},
+		{"upstream server", "http { upstream a { server 127.0.0.1 weight=5; } }", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse("test", tt.input)
+			switch {
+			case tt.err == "" && err != nil:
+				t.Fatalf("unexpected error: %s", err)
+			case tt.err != "" && err == nil:
+				t.Fatalf("expected error %q, got none", tt.err)
+			case tt.err != "" && err.Error() != tt.err:
+				t.Fatalf("expected error %q, got %q", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestArgumentsValidationMultipleMasks(t *testing.T) {
+	tree := New("test")
+	tree.Mode = SkipContextCheck
+	_, err := tree.Parse("server;")
+	expected := `test:1:1: invalid arguments in "server" directive, expected NGX_CONF_NOARGS|NGX_CONF_BLOCK or NGX_CONF_1MORE`
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got %v", expected, err)
+	}
+}
+
+func TestAllErrors(t *testing.T) {
+	input := `user www;
+foo bar;
+http {
+    sendfile maybe;
+    server {
+        listen 80
+    }
+    location / {}
+    ;
+}
+events { worker_connections 10; }
+`
+	tree := New("test")
+	tree.Mode = AllErrors
+	_, err := tree.Parse(input)
+	if err == nil {
+		t.Fatal("expected errors, got none")
+	}
+
+	errs, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("expected an ErrorList, got %T", err)
+	}
+
+	expected := []struct {
+		line, col int
+		kind      ErrorKind
+	}{
+		{2, 1, KindUnknownDirective},
+		{4, 5, KindArguments},
+		{7, 5, KindSyntax},
+		{8, 5, KindContext},
+		{9, 5, KindSyntax},
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(errs), errs)
+	}
+	for i, e := range expected {
+		got := errs[i]
+		if got.Line != e.line || got.Column != e.col || got.Kind != e.kind || got.Severity != SeverityError {
+			t.Errorf("error %d: expected %d:%d (%s), got %d:%d (%s): %s", i, e.line, e.col, e.kind, got.Line, got.Column, got.Kind, got)
+		}
+	}
+
+	// the tree must still contain everything that could be parsed.
+	var names []string
+	for _, node := range tree.Root.Nodes {
+		if d, ok := node.(*DirectiveNode); ok {
+			names = append(names, d.Text)
+		}
+	}
+	if strings.Join(names, " ") != "user foo http events" {
+		t.Fatalf("unexpected top level directives: %q", names)
+	}
+}
+
+func TestAllErrorsLexer(t *testing.T) {
+	tree := New("test")
+	tree.Mode = AllErrors
+	_, err := tree.Parse("http {\n  index \"a\"b;\n  sendfile on;\n")
+	errs, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("expected an ErrorList, got %T (%v)", err, err)
+	}
+	if len(errs) != 2 || errs[0].Kind != KindLex || errs[1].Kind != KindLex {
+		t.Fatalf("expected two lexer errors, got %v", errs)
+	}
+	if errs[0].Line != 2 || errs[0].Column != 12 {
+		t.Fatalf("expected first error at 2:12, got %d:%d", errs[0].Line, errs[0].Column)
+	}
+}
+
+func TestLineEndings(t *testing.T) {
+	input := "\uFEFF# main\r\nuser nginx;\r\n\r\nhttp {\r\n    sendfile on; # fast\r\n}\r\n"
+	tree, err := Parse("test", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := tree.Root.Nodes[0].(*DirectiveNode)
+	if user.Text != "user" || user.Leading[0].Text != " main" {
+		t.Errorf("unexpected first directive: %q, leading comment %q", user.Text, user.Leading[0].Text)
+	}
+	if _, ok := tree.Root.Nodes[1].(*EmptyLineNode); !ok {
+		t.Errorf("expected an empty line, got %s", tree.Root.Nodes[1].Type())
+	}
+	sendfile := block(tree.Root, 2).List.Nodes[0].(*DirectiveNode)
+	if sendfile.Args[0].(*ArgumentNode).Raw() != "on" || sendfile.Trailing.Text != " fast" {
+		t.Errorf("unexpected directive: %s", sendfile)
+	}
+	if span := sendfile.Span(); span.Start.Line != 5 || span.Start.Column != 5 {
+		t.Errorf("unexpected position: %s", span.Start)
+	}
+	if got := writeTree(t, tree); got != input {
+		t.Errorf("output differs from the input: %q", got)
+	}
+}
+
+func TestInvalidEncoding(t *testing.T) {
+	tree := New("test")
+	tree.Mode = AllErrors | SkipContextCheck
+	tree, err := tree.Parse("user nginx;\nerror_log /var/log/\xe9rror.log;\n# caf\xe9\ncontent_by_lua_block { ngx.say('\xff') }\n")
+	errs, ok := err.(ErrorList)
+	if !ok {
+		t.Fatalf("expected an ErrorList, got %T (%v)", err, err)
+	}
+	expected := []string{
+		"test:2:20: invalid UTF-8 encoding",
+		"test:3:6: invalid UTF-8 encoding",
+		"test:4:33: invalid UTF-8 encoding",
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	for i, e := range errs {
+		if e.Error() != expected[i] || e.Kind != KindLex {
+			t.Errorf("unexpected error: %s", e)
+		}
+	}
+	// the invalid bytes are kept.
+	lua := tree.Root.Nodes[2].(*DirectiveNode).Args[0].(*BlockLua)
+	if lua.Code() != " ngx.say('\xff') " {
+		t.Errorf("unexpected Lua code: %q", lua.Code())
+	}
+}
+
+func TestFirstErrorOnly(t *testing.T) {
+	_, err := Parse("test", "foo;\nbar;\n")
+	perr, ok := err.(*ParseError)
+	if !ok {
+		t.Fatalf("expected a *ParseError, got %T", err)
+	}
+	if perr.Error() != `test:1:1: unknown directive "foo"` {
+		t.Fatalf("unexpected error: %s", perr)
+	}
+}
+
+func TestAttachComments(t *testing.T) {
+	input := `# standalone
+
+# about the server
+# second line
+server {
+    listen 80; # public
+    server_name example.com # primary
+        example.org; # and its twin
+    # last
+}
+`
+	tree := New("test")
+	tree.Mode = SkipContextCheck
+	if _, err := tree.Parse(input); err != nil {
+		t.Fatal(err)
+	}
+
+	if c, ok := tree.Root.Nodes[0].(*CommentNode); !ok || c.Text != " standalone" {
+		t.Fatalf("expected a standalone comment, got %v", tree.Root.Nodes[0])
+	}
+	server := tree.Root.Nodes[2].(*DirectiveNode)
+	if len(server.Leading) != 2 || server.Leading[0].Text != " about the server" || server.Leading[1].Text != " second line" {
+		t.Fatalf("unexpected leading comments: %v", server.Leading)
+	}
+
+	block := server.Args[0].(*BlockNode)
+	listen := block.List.Nodes[0].(*DirectiveNode)
+	if listen.Trailing == nil || listen.Trailing.Text != " public" {
+		t.Fatalf("unexpected trailing comment: %v", listen.Trailing)
+	}
+	serverName := block.List.Nodes[1].(*DirectiveNode)
+	if len(serverName.Inline) != 1 || serverName.Inline[0].Text != " primary" || len(serverName.Args) != 2 {
+		t.Fatalf("unexpected inline comments: %v", serverName.Inline)
+	}
+	if serverName.Trailing == nil || serverName.Trailing.Text != " and its twin" {
+		t.Fatalf("unexpected trailing comment: %v", serverName.Trailing)
+	}
+	if c, ok := block.List.Nodes[2].(*CommentNode); !ok || c.Text != " last" {
+		t.Fatalf("expected a standalone comment at the end of the block, got %v", block.List.Nodes[2])
+	}
+
+	// the comments are carried by copies and by the configuration.
+	cp := server.Copy().(*DirectiveNode)
+	if len(cp.Leading) != 2 || cp.Leading[0] == server.Leading[0] {
+		t.Fatalf("comments are not copied: %v", cp.Leading)
+	}
+	cfg, err := NewConfiguration(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	comments := cfg.Directives[0].Block[1].Comments
+	if comments == nil || comments.Trailing != " and its twin" || len(comments.Inline) != 1 {
+		t.Fatalf("unexpected comments in the configuration: %+v", comments)
+	}
+}
+
+func TestLuaBlocks(t *testing.T) {
+	input := `http {
+    init_by_lua_block {
+        require "resty.core"
+    }
+    server {
+        location / {
+            set_by_lua_block $x { return "}" }
+            content_by_lua_block {
+                -- a comment with a bracket: }
+                local t = { [[#]], "{" }
+                ngx.say(t[1])
+            }
+        }
+    }
+}
+`
+	tree, err := Parse("test", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var blocks []*BlockLua
+	var walk func(l *ListNode)
+	walk = func(l *ListNode) {
+		for _, n := range l.Nodes {
+			d := n.(*DirectiveNode)
+			switch b := d.Args[len(d.Args)-1].(type) {
+			case *BlockNode:
+				walk(b.List)
+			case *BlockLua:
+				blocks = append(blocks, b)
+			}
+		}
+	}
+	walk(tree.Root)
+
+	if len(blocks) != 3 {
+		t.Fatalf("expected 3 Lua blocks, got %d", len(blocks))
+	}
+	expected := []string{
+		"\n        require \"resty.core\"\n    ",
+		` return "}" `,
+		"\n                -- a comment with a bracket: }\n                local t = { [[#]], \"{\" }\n                ngx.say(t[1])\n            ",
+	}
+	for i, b := range blocks {
+		if b.Code() != expected[i] {
+			t.Errorf("block %d: unexpected code %q", i, b.Code())
+		}
+		span := b.CodeSpan()
+		if got := input[span.Start.Offset:span.End.Offset]; got != expected[i] {
+			t.Errorf("block %d: unexpected span %v", i, span)
+		}
+	}
+	if span := blocks[1].CodeSpan(); span.Start.Line != 7 || span.Start.Column != 34 {
+		t.Errorf("unexpected start of the code: %s", span.Start)
+	}
+
+	cfg, err := NewConfiguration(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lua := cfg.Directives[0].Block[0].Lua; lua != expected[0] {
+		t.Errorf("unexpected code in the configuration: %q", lua)
+	}
+}
+
+func BenchmarkParse(b *testing.B) {
+	data, err := os.ReadFile("testdata/nginx.conf")
+	if err != nil {
+		b.Fatal(err)
+	}
+	text := string(data)
+	b.SetBytes(int64(len(text)))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := Parse("nginx.conf", text); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestParseReader(t *testing.T) {
+	input := `# café
+http {
+    server {
+        listen 80;
+        location / {
+            content_by_lua_block {
+                local s = [==[ } ]==] -- }
+            }
+        }
+    }
+}
+`
+	// one byte at a time, so that runes and tokens are split between reads.
+	tree, err := ParseReader("test", iotest.OneByteReader(strings.NewReader(input)), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	if _, err := tree.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != input {
+		t.Fatalf("unexpected output:\n%s", b.String())
+	}
+
+	// errors have the same positions as when parsing a string.
+	bad := strings.Replace(input, "listen 80;", "listen 80 {", 1)
+	_, err = ParseReader("test", iotest.HalfReader(strings.NewReader(bad)), 0)
+	_, expected := Parse("test", bad)
+	if err == nil || expected == nil || err.Error() != expected.Error() {
+		t.Fatalf("expected error %v, got %v", expected, err)
+	}
+
+	// read errors are returned as they are.
+	_, err = ParseReader("test", iotest.TimeoutReader(iotest.OneByteReader(strings.NewReader(input))), 0)
+	if err != iotest.ErrTimeout {
+		t.Fatalf("expected a timeout, got %v", err)
+	}
+}
@@ -0,0 +1,323 @@
+package nginx
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// WriteTo writes the configuration represented by the tree to w. The output of a tree that
+// has not been modified is identical to the parsed text; when the tree has been edited, only
+// the changed parts are rendered again, while the rest of the text, including whitespace
+// and discarded comments, is copied from the original.
+//
+// New nodes, created with NewDirective and the other constructors, are written on their own
+// line, indented like the nodes around them.
+func (t *Tree) WriteTo(w io.Writer) (int64, error) {
+	if t.Root == nil {
+		return 0, nil
+	}
+	p := printer{unit: "    "}
+	p.list(t.Root, "", "", 0, len(t.text))
+	n, err := w.Write(p.buf.Bytes())
+	return int64(n), err
+}
+
+// snapshot records the original state of the nodes reachable from n, so that the printer can
+// tell which parts of the tree have been modified.
+func snapshot(n Node) {
+	switch n := n.(type) {
+	case *ListNode:
+		n.orig = append([]Node(nil), n.Nodes...)
+		for _, node := range n.Nodes {
+			snapshot(node)
+		}
+	case *DirectiveNode:
+		n.origText = n.Text
+		n.origArgs = append([]Node(nil), n.Args...)
+		n.origLeading = append([]*CommentNode(nil), n.Leading...)
+		n.origTrailing = n.Trailing
+		n.origInline = append([]*CommentNode(nil), n.Inline...)
+		for _, arg := range n.Args {
+			snapshot(arg)
+		}
+	case *BlockNode:
+		snapshot(n.List)
+	case *BlockLua:
+		n.orig = append([]string(nil), n.Lines...)
+	}
+}
+
+// printer renders a tree, reusing the original text of the nodes that were not modified.
+type printer struct {
+	buf  bytes.Buffer
+	unit string // one level of indentation, as used by the original text.
+}
+
+// list writes the nodes of l, indented by indent; parent is the indentation of the enclosing
+// block, and open and close are the offsets, in the text of l, where the list starts and ends.
+func (p *printer) list(l *ListNode, indent, parent string, open, close int) {
+	sep := func(prev, n Node) {
+		p.newline()
+		if _, ok := n.(*EmptyLineNode); ok {
+			p.buf.WriteByte('\n')
+			return
+		}
+		p.buf.WriteString(indent)
+	}
+	write := func(n Node, adjacent bool) {
+		if e, ok := n.(*EmptyLineNode); ok {
+			if adjacent {
+				p.buf.WriteString(e.tr.text[e.Pos:e.end])
+			}
+			return
+		}
+		p.node(n, indent)
+	}
+
+	from := p.sequence(l.tr, l.orig, l.Nodes, open, sep, write)
+	switch {
+	case from >= 0:
+		p.buf.WriteString(l.tr.text[from:close])
+	case len(l.Nodes) > 0 || len(l.orig) > 0:
+		p.newline()
+		p.buf.WriteString(parent)
+	}
+}
+
+// sequence writes nodes, whose original sequence was orig, copying the text of tr between the
+// nodes that are still adjacent; from is the offset where the text before the first node starts.
+// The function sep writes the separator before a node that is not adjacent to the previous one
+// (prev is nil for the first node), and write writes a node. sequence returns the offset of the
+// text after the last node, or -1 when the last node is not the last of the original sequence.
+func (p *printer) sequence(tr *Tree, orig, nodes []Node, from int, sep func(prev, n Node), write func(n Node, adjacent bool)) int {
+	index := make(map[Node]int, len(orig))
+	for i, n := range orig {
+		index[n] = i
+	}
+
+	prev := -1
+	var last Node
+	for _, n := range nodes {
+		i, ok := index[n]
+		ok = ok && tr != nil && n.tree() == tr
+		adjacent := ok && i == prev+1
+		if adjacent {
+			p.buf.WriteString(tr.text[from:outerStart(n)])
+		} else {
+			sep(last, n)
+		}
+		write(n, adjacent)
+
+		prev, last = -2, n // -2 is never adjacent.
+		if ok {
+			prev = i
+		}
+		from = int(outerEnd(n))
+	}
+	if tr != nil && prev+1 == len(orig) {
+		return from
+	}
+	return -1
+}
+
+// newline terminates the current line, unless the output is empty or already ends with a newline.
+func (p *printer) newline() {
+	if b := p.buf.Bytes(); len(b) > 0 && b[len(b)-1] != '\n' {
+		p.buf.WriteByte('\n')
+	}
+}
+
+// node writes n, where indent is the indentation of the line where n starts.
+func (p *printer) node(n Node, indent string) {
+	switch n := n.(type) {
+	case *CommentNode:
+		p.buf.WriteString(n.String())
+	case *DirectiveNode:
+		p.directive(n, indent)
+	case *ArgumentNode:
+		p.buf.WriteString(n.String())
+	case *BlockNode:
+		p.buf.WriteByte('{')
+		p.list(n.List, p.indent(n.List, indent), indent, int(n.List.Pos), int(n.List.end))
+		p.buf.WriteByte('}')
+	case *BlockLua:
+		p.lua(n)
+	}
+}
+
+// directive writes a directive with its arguments and comments, reusing the original text
+// between the parts that were not modified.
+func (p *printer) directive(d *DirectiveNode, indent string) {
+	var text string
+	if d.tr != nil {
+		text = d.tr.text
+	}
+
+	if len(d.Leading) > 0 && d.tr != nil && sameComments(d.Leading, d.origLeading) && unchanged(d.Leading...) {
+		p.buf.WriteString(text[d.origLeading[0].Pos:d.Pos])
+	} else {
+		for _, c := range d.Leading {
+			p.buf.WriteString(c.String())
+			p.buf.WriteByte('\n')
+			p.buf.WriteString(indent)
+		}
+	}
+
+	p.buf.WriteString(d.Text)
+
+	// the arguments are treated like a list that starts right after the name of the directive;
+	// a new line follows the comments between them.
+	args := withInline(d.Args, d.Inline)
+	sep := func(prev, n Node) {
+		if _, ok := prev.(*CommentNode); ok {
+			p.buf.WriteByte('\n')
+			p.buf.WriteString(indent + p.unit)
+		} else {
+			p.buf.WriteByte(' ')
+		}
+	}
+	write := func(n Node, adjacent bool) {
+		p.node(n, indent)
+	}
+	from := p.sequence(d.tr, withInline(d.origArgs, d.origInline), args, int(d.Pos)+len(d.origText), sep, write)
+
+	switch {
+	case from >= 0:
+		// the terminator as it was.
+		p.buf.WriteString(text[from:d.end])
+	case len(args) > 0 && isBlock(args[len(args)-1]):
+	default:
+		if len(args) > 0 {
+			if _, ok := args[len(args)-1].(*CommentNode); ok {
+				p.buf.WriteByte('\n')
+				p.buf.WriteString(indent)
+			}
+		}
+		p.buf.WriteByte(';')
+	}
+
+	switch {
+	case d.Trailing != nil && d.Trailing == d.origTrailing && d.tr != nil && unchanged(d.Trailing):
+		p.buf.WriteString(text[d.end:d.origTrailing.end])
+	case d.Trailing != nil:
+		p.buf.WriteByte(' ')
+		p.buf.WriteString(d.Trailing.String())
+	}
+}
+
+// lua writes a block of Lua code.
+func (p *printer) lua(bl *BlockLua) {
+	if bl.tr != nil && bl.end > end(bl.Pos) && sameLines(bl.Lines, bl.orig) {
+		p.buf.WriteString(bl.tr.text[bl.Pos:bl.end])
+		return
+	}
+	p.buf.WriteByte('{')
+	p.buf.WriteString(bl.Code())
+	p.buf.WriteByte('}')
+}
+
+// unchanged reports whether the text of the comments is still the parsed one.
+func unchanged(cs ...*CommentNode) bool {
+	for _, c := range cs {
+		if c.tr == nil || c.tr.text[c.Pos+1:c.end] != c.Text {
+			return false
+		}
+	}
+	return true
+}
+
+// outerStart returns the position where a node starts, including its leading comments.
+func outerStart(n Node) Pos {
+	if d, ok := n.(*DirectiveNode); ok && len(d.origLeading) > 0 {
+		return d.origLeading[0].Pos
+	}
+	return n.Position()
+}
+
+// outerEnd returns the position where a node ends, including its trailing comment.
+func outerEnd(n Node) Pos {
+	if d, ok := n.(*DirectiveNode); ok && d.origTrailing != nil {
+		return d.origTrailing.End()
+	}
+	return n.End()
+}
+
+// withInline returns the arguments of a directive together with its inline comments, in the
+// order in which they appear in the text; the comments without a position come after the
+// other arguments, but before the block.
+func withInline(args []Node, inline []*CommentNode) []Node {
+	if len(inline) == 0 {
+		return args
+	}
+	res := make([]Node, 0, len(args)+len(inline))
+	i := 0
+	for _, a := range args {
+		for ; i < len(inline); i++ {
+			c := inline[i]
+			if !isBlock(a) && (c.tr == nil || c.tr != a.tree() || c.Pos > a.Position()) {
+				break
+			}
+			res = append(res, c)
+		}
+		res = append(res, a)
+	}
+	return append(res, inlineNodes(inline[i:])...)
+}
+
+// inlineNodes converts a list of comments to a list of nodes.
+func inlineNodes(cs []*CommentNode) []Node {
+	res := make([]Node, len(cs))
+	for i, c := range cs {
+		res[i] = c
+	}
+	return res
+}
+
+// isBlock reports whether n is a block, which terminates a directive.
+func isBlock(n Node) bool {
+	switch n.(type) {
+	case *BlockNode, *BlockLua:
+		return true
+	}
+	return false
+}
+
+// indent returns the indentation of the nodes of l: the indentation of the first node that
+// starts on its own line, or the indentation of the parent block plus one level.
+func (p *printer) indent(l *ListNode, parent string) string {
+	for _, n := range l.Nodes {
+		switch n.(type) {
+		case *DirectiveNode, *CommentNode:
+		default:
+			continue
+		}
+		tr := n.tree()
+		if tr == nil {
+			continue
+		}
+		pos := int(n.Position())
+		start := strings.LastIndexByte(tr.text[:pos], '\n') + 1
+		if prefix := tr.text[start:pos]; strings.Trim(prefix, " \t") == "" {
+			// remember the unit of indentation, to indent new blocks in the same way.
+			if len(prefix) > len(parent) && strings.HasPrefix(prefix, parent) {
+				p.unit = prefix[len(parent):]
+			}
+			return prefix
+		}
+	}
+	return parent + p.unit
+}
+
+// sameLines reports whether a and b contain the same lines.
+func sameLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,254 @@
+package nginx
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func writeTree(t *testing.T, tree *Tree) string {
+	t.Helper()
+	var b strings.Builder
+	if _, err := tree.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestWriteToRoundTrip(t *testing.T) {
+	files, err := Unpack("testdata/docker_nginx_t.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, filename := range []string{"testdata/nginx.conf", "../sample/nginx_server.conf"} {
+		b, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[filename] = string(b)
+	}
+	// odd spacing, comments between arguments and invalid text must survive too.
+	files["odd"] = "  # leading\n\nuser  nginx\t;\nevents{worker_connections # n\n 1024 ;}\n\n\n}\nhttp { server { listen 80; } }"
+
+	for name, text := range files {
+		t.Run(name, func(t *testing.T) {
+			tree := New(name)
+			tree.Mode = SkipContextCheck | AllErrors
+			tree, _ = tree.Parse(text)
+			if got := writeTree(t, tree); got != text {
+				t.Errorf("output differs from the input:\n%s", got)
+			}
+			if got := writeTree(t, &Tree{Root: tree.Root.CopyList(), text: tree.text}); got != text {
+				t.Errorf("output of the copy differs from the input:\n%s", got)
+			}
+		})
+	}
+}
+
+func TestWriteToEdits(t *testing.T) {
+	const text = `# main
+user  nginx;
+
+http {
+	server {
+		listen       80;   # http
+		server_name  example.com;
+	}
+}
+`
+	var tests = []struct {
+		name     string
+		edit     func(root *ListNode)
+		expected string
+	}{
+		{
+			"change argument",
+			func(root *ListNode) {
+				server := block(root, 2, 0)
+				listen := server.List.Nodes[0].(*DirectiveNode)
+				listen.Args[0].(*ArgumentNode).Value = "8080"
+			},
+			`# main
+user  nginx;
+
+http {
+	server {
+		listen       8080;   # http
+		server_name  example.com;
+	}
+}
+`,
+		},
+		{
+			"change value",
+			func(root *ListNode) {
+				server := block(root, 2, 0)
+				name := server.List.Nodes[1].(*DirectiveNode)
+				name.Args[0].(*ArgumentNode).Value = "{example.com}"
+				server.List.Nodes = append(server.List.Nodes, NewDirective("add_header", "X-Note", "a; b"))
+			},
+			`# main
+user  nginx;
+
+http {
+	server {
+		listen       80;   # http
+		server_name  "{example.com}";
+		add_header X-Note "a; b";
+	}
+}
+`,
+		},
+		{
+			"add argument",
+			func(root *ListNode) {
+				user := root.Nodes[0].(*DirectiveNode)
+				user.Args = append(user.Args, NewArgument("nginx"))
+			},
+			`# main
+user  nginx nginx;
+
+http {
+	server {
+		listen       80;   # http
+		server_name  example.com;
+	}
+}
+`,
+		},
+		{
+			"add directive",
+			func(root *ListNode) {
+				server := block(root, 2, 0)
+				server.List.Nodes = append(server.List.Nodes, NewDirective("root", "/srv"))
+			},
+			`# main
+user  nginx;
+
+http {
+	server {
+		listen       80;   # http
+		server_name  example.com;
+		root /srv;
+	}
+}
+`,
+		},
+		{
+			"add block",
+			func(root *ListNode) {
+				server := block(root, 2, 0)
+				location := NewDirective("location", "/")
+				location.Args = append(location.Args, NewBlock(NewDirective("return", "404")))
+				server.List.Nodes = append([]Node{location}, server.List.Nodes...)
+			},
+			`# main
+user  nginx;
+
+http {
+	server {
+		location / {
+			return 404;
+		}
+		listen       80;   # http
+		server_name  example.com;
+	}
+}
+`,
+		},
+		{
+			"remove directive",
+			func(root *ListNode) {
+				server := block(root, 2, 0)
+				// the trailing comment is removed with the directive.
+				server.List.Nodes = server.List.Nodes[1:]
+			},
+			`# main
+user  nginx;
+
+http {
+	server {
+		server_name  example.com;
+	}
+}
+`,
+		},
+		{
+			"change comments",
+			func(root *ListNode) {
+				root.Nodes[0].(*DirectiveNode).Leading[0].Text = " edited"
+				http := root.Nodes[2].(*DirectiveNode)
+				http.Leading = append(http.Leading, NewComment(" web"))
+				server := block(root, 2, 0)
+				server.List.Nodes[0].(*DirectiveNode).Trailing = NewComment(" plain")
+			},
+			`# edited
+user  nginx;
+
+# web
+http {
+	server {
+		listen       80; # plain
+		server_name  example.com;
+	}
+}
+`,
+		},
+		{
+			"remove last directive",
+			func(root *ListNode) {
+				root.Nodes = root.Nodes[:1]
+			},
+			`# main
+user  nginx;
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := Parse("test", text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.edit(tree.Root)
+			if got := writeTree(t, tree); got != tt.expected {
+				t.Errorf("unexpected output:\n%s", got)
+			}
+		})
+	}
+}
+
+func TestWriteToLua(t *testing.T) {
+	input := `location / {
+    content_by_lua_block {
+        ngx.say("}")
+    }
+}
+`
+	tree := New("test")
+	tree.Mode = SkipContextCheck
+	tree, err := tree.Parse(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lua := block(tree.Root, 0).List.Nodes[0].(*DirectiveNode).Args[0].(*BlockLua)
+	lua.Lines[1] = `        ngx.say("{")`
+
+	expected := strings.Replace(input, `"}"`, `"{"`, 1)
+	if got := writeTree(t, tree); got != expected {
+		t.Errorf("unexpected output:\n%s", got)
+	}
+}
+
+// block returns the block of the directive at index i of list, and then of the directives at
+// the following indexes inside it.
+func block(list *ListNode, indexes ...int) *BlockNode {
+	var b *BlockNode
+	for _, i := range indexes {
+		d := list.Nodes[i].(*DirectiveNode)
+		b = d.Args[len(d.Args)-1].(*BlockNode)
+		list = b.List
+	}
+	return b
+}
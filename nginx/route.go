@@ -0,0 +1,150 @@
+package nginx
+
+import (
+	"errors"
+	"strings"
+)
+
+// Route is the result of the selection of the server and the location handling a request.
+type Route struct {
+	Server     *DirectiveNode // the server directive handling the request.
+	Location   *Location      // the location handling the request; nil if no location matches.
+	Candidates []Candidate    // the locations considered, in the order in which nginx checks them.
+}
+
+// Candidate is a location considered while looking for the location of a request.
+type Candidate struct {
+	Location *Location
+	Matched  bool   // the location matches the URI.
+	Reason   string // why the location was chosen or discarded.
+}
+
+// FindRoute returns the server and the location that handle a request for the given host and
+// URI, according to the configuration in tree, which must contain an http block; the query
+// string of the URI is ignored, while the rest of the URI is matched as it is, so it should be
+// already normalized like nginx does, e.g. decoding the escaped characters.
+//
+// The location is chosen like nginx does: the locations with the "=" modifier are checked
+// first, and an exact match ends the search; otherwise the longest matching prefix is
+// remembered and the search continues among the locations nested in it. Then, unless the
+// prefix has the "^~" modifier, the regular expressions are checked in the order in which they
+// appear, and the first one matching the URI is chosen, again after looking at the locations
+// nested in it; if no regular expression matches, the longest prefix is used.
+func FindRoute(tree *Tree, host, uri string) (*Route, error) {
+	server := FindServer(tree, host)
+	if server == nil {
+		return nil, errors.New("no server blocks found")
+	}
+	return FindLocation(server, uri), nil
+}
+
+// FindLocation returns the location of server that handles a request for uri, like FindRoute;
+// the server can be chosen with FindServer or VirtualHosts.Resolve.
+func FindLocation(server *DirectiveNode, uri string) *Route {
+	if i := strings.IndexByte(uri, '?'); i >= 0 {
+		uri = uri[:i]
+	}
+	r := &Route{Server: server}
+	r.Location, _ = r.findLocation(locations(server), uri)
+	return r
+}
+
+// findLocation looks for the location of uri among locs, which are the locations in the same
+// block, and among the locations nested in them. It returns the location found, if any, and
+// whether the search is over, because of an exact or a regex match.
+func (r *Route) findLocation(locs []*Location, uri string) (*Location, bool) {
+	for _, l := range locs {
+		if l.Modifier != LocationExact {
+			continue
+		}
+		if l.Pattern == uri {
+			r.candidate(l, true, "exact match")
+			return l, true
+		}
+		r.candidate(l, false, "not an exact match")
+	}
+
+	var prefix *Location
+	for _, l := range locs {
+		if isPrefix(l) && strings.HasPrefix(uri, l.Pattern) && (prefix == nil || len(l.Pattern) > len(prefix.Pattern)) {
+			prefix = l
+		}
+	}
+	for _, l := range locs {
+		switch {
+		case !isPrefix(l):
+		case l == prefix:
+			r.candidate(l, true, "longest prefix")
+		case strings.HasPrefix(uri, l.Pattern):
+			r.candidate(l, true, "shorter prefix")
+		default:
+			r.candidate(l, false, "not a prefix")
+		}
+	}
+
+	found := prefix
+	if prefix != nil {
+		nested, done := r.findLocation(locations(prefix.Directive), uri)
+		if done {
+			return nested, true
+		}
+		if nested != nil {
+			found = nested
+		}
+	}
+
+	noRegex := prefix != nil && prefix.Modifier == LocationPrefixNoRegex
+	for _, l := range locs {
+		switch {
+		case !l.IsRegex():
+		case noRegex:
+			r.candidate(l, false, "skipped because of the ^~ prefix")
+		case l.Regexp == nil:
+			r.candidate(l, false, "skipped: "+l.Warning)
+		case l.Regexp.MatchString(uri):
+			r.candidate(l, true, "regex match")
+			if nested, _ := r.findLocation(locations(l.Directive), uri); nested != nil {
+				return nested, true
+			}
+			return l, true
+		default:
+			r.candidate(l, false, "regex doesn't match")
+		}
+	}
+	return found, false
+}
+
+// candidate records a location considered by findLocation.
+func (r *Route) candidate(l *Location, matched bool, reason string) {
+	r.Candidates = append(r.Candidates, Candidate{Location: l, Matched: matched, Reason: reason})
+}
+
+// isPrefix reports whether the location matches a prefix of the URI.
+func isPrefix(l *Location) bool {
+	return !l.Named && (l.Modifier == LocationPrefix || l.Modifier == LocationPrefixNoRegex)
+}
+
+// locations returns the locations in the block of d; the invalid locations are ignored.
+func locations(d *DirectiveNode) []*Location {
+	var locs []*Location
+	for _, n := range directiveBlock(d) {
+		if n.Text != "location" {
+			continue
+		}
+		if l, err := NewLocation(n); err == nil {
+			locs = append(locs, l)
+		}
+	}
+	return locs
+}
+
+// directiveBlock returns the directives in the block of d, if any.
+func directiveBlock(d *DirectiveNode) []*DirectiveNode {
+	if len(d.Args) == 0 {
+		return nil
+	}
+	if b, ok := d.Args[len(d.Args)-1].(*BlockNode); ok {
+		return blockDirectives(b)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package nginx
+
+import (
+	"strings"
+	"testing"
+)
+
+const routeConfig = `http {
+    server {
+        listen 80;
+        server_name example.com;
+
+        location = / {
+            return 200 exact;
+        }
+        location / {
+            return 200 root;
+        }
+        location /documents/ {
+            location ~ \.pdf$ {
+                return 200 nested-pdf;
+            }
+        }
+        location ^~ /images/ {
+            return 200 images;
+        }
+        location ~* \.(gif|jpg|jpeg)$ {
+            return 200 pictures;
+        }
+        location /static/ {
+            location /static/css/ {
+                return 200 css;
+            }
+        }
+        location ~ ^/static/ {
+            return 200 static-regex;
+        }
+        location @fallback {
+            return 200 fallback;
+        }
+    }
+    server {
+        listen 80 default_server;
+        server_name *.example.org www.example.*;
+        location / {
+            return 200 other;
+        }
+    }
+}
+`
+
+func TestFindRoute(t *testing.T) {
+	tree, err := Parse("test", routeConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tests = []struct {
+		host, uri string
+		server    string // the first server name.
+		location  string // the argument of the return directive of the location.
+	}{
+		{"example.com", "/", "example.com", "exact"},
+		{"example.com", "/index.html", "example.com", "root"},
+		{"example.com", "/documents/document.html", "example.com", ""},
+		{"example.com", "/documents/doc.pdf?x=1", "example.com", "nested-pdf"},
+		{"example.com", "/images/1.gif", "example.com", "images"},
+		{"example.com", "/documents/1.JPG", "example.com", "pictures"},
+		{"example.com", "/static/css/a.css", "example.com", "static-regex"},
+		{"EXAMPLE.com:8080", "/x", "example.com", "root"},
+		{"a.example.org", "/", "*.example.org", "other"},
+		{"www.example.net", "/", "*.example.org", "other"},
+		{"unknown", "/", "*.example.org", "other"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host+tt.uri, func(t *testing.T) {
+			r, err := FindRoute(tree, tt.host, tt.uri)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if names := serverNames(r.Server); names[0] != tt.server {
+				t.Errorf("unexpected server: %q", names)
+			}
+			if r.Location == nil {
+				t.Fatal("no location found")
+			}
+			var ret string
+			for _, d := range directiveBlock(r.Location.Directive) {
+				if d.Text == "return" {
+					ret = d.Args[1].String()
+				}
+			}
+			if ret != tt.location {
+				t.Errorf("unexpected location: %s %s", r.Location.Modifier, r.Location.Pattern)
+			}
+		})
+	}
+}
+
+func TestFindRouteCandidates(t *testing.T) {
+	tree, err := Parse("test", routeConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := FindRoute(tree, "example.com", "/images/a.gif")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, c := range r.Candidates {
+		got = append(got, c.Location.Modifier.String()+c.Location.Pattern+": "+c.Reason)
+	}
+	expected := []string{
+		"=/: not an exact match",
+		"/: shorter prefix",
+		"/documents/: not a prefix",
+		"^~/images/: longest prefix",
+		"/static/: not a prefix",
+		`~*\.(gif|jpg|jpeg)$: skipped because of the ^~ prefix`,
+		"~^/static/: skipped because of the ^~ prefix",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected candidates:\n%s", strings.Join(got, "\n"))
+	}
+}
@@ -1,4 +1,4 @@
-package parse
+package nginx
 
 import (
 	"bufio"
@@ -19,25 +19,33 @@ var magicHeader = regexp.MustCompile(`^# configuration file ([^:]+):\r?\n$`)
 // If filename contains a single nginx configuration file instead of a configuration dump,
 // the only key in the returned map will be filename.
 func Unpack(filename string) (map[string]string, error) {
-	files := make(map[string]string)
-
 	fh, err := os.Open(filename)
 	if err != nil {
 		return nil, err
 	}
 	defer fh.Close()
 
-	r := bufio.NewReader(fh)
+	return unpack(fh, filename)
+}
+
+// UnpackReader is like Unpack, but reads the output of `nginx -T` from r; if r contains a
+// single nginx configuration file, the only key in the returned map is the empty string.
+func UnpackReader(r io.Reader) (map[string]string, error) {
+	return unpack(r, "")
+}
+
+// unpack splits a configuration dump read from rd; filename is the key used for the
+// configuration that precedes the first file header, if any.
+func unpack(rd io.Reader, filename string) (map[string]string, error) {
+	files := make(map[string]string)
+
+	r := bufio.NewReader(rd)
 	b := strings.Builder{}
 	currentFilename := filename
 
-Loop:
 	for {
 		line, err := r.ReadString('\n')
-		switch {
-		case errors.Is(err, io.EOF):
-			break Loop
-		case err != nil:
+		if err != nil && !errors.Is(err, io.EOF) {
 			return nil, err
 		}
 
@@ -55,6 +63,11 @@ Loop:
 			b.Reset()
 			currentFilename = string(match[1])
 		}
+
+		// the last line may not be terminated by a newline.
+		if err != nil {
+			break
+		}
 	}
 
 	// don't forget to add the last file! :)
@@ -1,6 +1,8 @@
-package parse
+package nginx
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -40,3 +42,26 @@ func TestUnpackFilenames(t *testing.T) {
 		})
 	}
 }
+
+func TestUnpackReader(t *testing.T) {
+	dump := "# configuration file /etc/nginx/nginx.conf:\r\nevents {}\n\n# configuration file /etc/nginx/conf.d/a.conf:\nserver {}"
+	files, err := UnpackReader(strings.NewReader(dump))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"/etc/nginx/nginx.conf":    "events {}\n\n",
+		"/etc/nginx/conf.d/a.conf": "server {}",
+	}
+	if !reflect.DeepEqual(files, expected) {
+		t.Fatalf("unexpected files: %q", files)
+	}
+
+	files, err = UnpackReader(strings.NewReader("events {}\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[""] != "events {}\n" {
+		t.Fatalf("unexpected files: %q", files)
+	}
+}
@@ -0,0 +1,448 @@
+package nginx
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// The kinds of server names, in order of priority.
+const (
+	nameExact = iota
+	nameLeadingWildcard
+	nameTrailingWildcard
+	nameRegex
+	nameNone
+)
+
+// Listen is the typed view of a listen directive of the http context.
+type Listen struct {
+	Address       string         // an IP address, "*" or "[::]" for any address, a host name, or "unix:" and a path.
+	Port          int            // 0 for UNIX-domain sockets.
+	DefaultServer bool           // the default_server (or default) parameter.
+	SSL           bool           // the ssl parameter.
+	HTTP2         bool           // the http2 parameter.
+	ProxyProtocol bool           // the proxy_protocol parameter.
+	Directive     *DirectiveNode // the listen directive; nil for the implicit "listen *:80".
+}
+
+// Socket returns the address and the port of the listen directive, as in "*:80".
+func (l *Listen) Socket() string {
+	if l.Port == 0 {
+		return l.Address
+	}
+	return l.Address + ":" + strconv.Itoa(l.Port)
+}
+
+// VirtualServer is a server block of the http context, with its listen directives and names.
+type VirtualServer struct {
+	Listens   []*Listen
+	Names     []string // the server names; "" when there is no server_name directive.
+	Directive *DirectiveNode
+}
+
+// Socket is an address and a port where nginx accepts connections, with the servers listening
+// on it.
+type Socket struct {
+	Address         string
+	Port            int
+	Servers         []*VirtualServer // in the order in which they appear in the configuration.
+	Default         *VirtualServer   // the server handling the requests that match no server name.
+	ImplicitDefault bool             // no listen directive has the default_server parameter.
+	SSL             bool             // a listen directive for the socket has the ssl parameter.
+	HTTP2           bool             // a listen directive for the socket has the http2 parameter.
+	ProxyProtocol   bool             // a listen directive for the socket has the proxy_protocol parameter.
+}
+
+func (s *Socket) String() string {
+	l := Listen{Address: s.Address, Port: s.Port}
+	return l.Socket()
+}
+
+// Conflict is a problem found while building the table of the virtual servers: a server name
+// used by two servers on the same socket, which nginx ignores in the second server with the
+// warning "conflicting server name", or two default servers on the same socket, which nginx
+// refuses.
+type Conflict struct {
+	Socket *Socket
+	Name   string         // the conflicting server name; empty for duplicate default servers.
+	First  *VirtualServer // the server that wins.
+	Second *VirtualServer // the server that is ignored.
+	Msg    string
+}
+
+func (c *Conflict) String() string {
+	return c.Second.Directive.Span().Start.String() + ": " + c.Msg
+}
+
+// VirtualHosts is the table of the virtual servers of the http context, which nginx uses to
+// choose the server handling a request from the socket that accepted the connection and the
+// Host header.
+type VirtualHosts struct {
+	Servers   []*VirtualServer
+	Sockets   []*Socket // in the order in which they first appear in the configuration.
+	Conflicts []*Conflict
+}
+
+// NewVirtualHosts builds the table of the virtual servers of the http blocks in tree. A server
+// without listen directives listens on "*:80", and the default server of a socket is the one
+// with the default_server parameter or, implicitly, the first one listening on it.
+func NewVirtualHosts(tree *Tree) (*VirtualHosts, error) {
+	vh := &VirtualHosts{}
+	sockets := make(map[string]*Socket)
+	for _, d := range httpServers(tree) {
+		s := &VirtualServer{Directive: d, Names: serverNames(d)}
+		if len(s.Names) == 0 {
+			s.Names = []string{""}
+		}
+		for _, ld := range directiveBlock(d) {
+			if ld.Text != "listen" {
+				continue
+			}
+			l, err := NewListen(ld)
+			if err != nil {
+				return nil, err
+			}
+			s.Listens = append(s.Listens, l)
+		}
+		if len(s.Listens) == 0 {
+			s.Listens = []*Listen{{Address: "*", Port: 80}}
+		}
+		vh.Servers = append(vh.Servers, s)
+
+		for _, l := range s.Listens {
+			sock := sockets[l.Socket()]
+			if sock == nil {
+				sock = &Socket{Address: l.Address, Port: l.Port}
+				sockets[l.Socket()] = sock
+				vh.Sockets = append(vh.Sockets, sock)
+			}
+			vh.addServer(sock, s, l)
+		}
+	}
+
+	for _, sock := range vh.Sockets {
+		if sock.Default == nil {
+			sock.Default, sock.ImplicitDefault = sock.Servers[0], true
+		}
+	}
+	return vh, nil
+}
+
+// addServer adds the server s, listening with l, to sock, recording the conflicts with the
+// servers already listening on it.
+func (vh *VirtualHosts) addServer(sock *Socket, s *VirtualServer, l *Listen) {
+	sock.SSL = sock.SSL || l.SSL
+	sock.HTTP2 = sock.HTTP2 || l.HTTP2
+	sock.ProxyProtocol = sock.ProxyProtocol || l.ProxyProtocol
+	if l.DefaultServer {
+		if sock.Default != nil && sock.Default != s {
+			vh.Conflicts = append(vh.Conflicts, &Conflict{
+				Socket: sock,
+				First:  sock.Default,
+				Second: s,
+				Msg:    "a duplicate default server for " + sock.String(),
+			})
+		} else {
+			sock.Default = s
+		}
+	}
+
+	for _, other := range sock.Servers {
+		if other == s {
+			// another listen directive of the same server.
+			return
+		}
+		for _, name := range s.Names {
+			// like nginx, the regular expressions and the empty name are not checked.
+			if name == "" || strings.HasPrefix(name, "~") || !hasName(other, name) {
+				continue
+			}
+			vh.Conflicts = append(vh.Conflicts, &Conflict{
+				Socket: sock,
+				Name:   name,
+				First:  other,
+				Second: s,
+				Msg:    fmt.Sprintf("conflicting server name %q on %s, ignored", name, sock),
+			})
+		}
+	}
+	sock.Servers = append(sock.Servers, s)
+}
+
+// hasName reports whether name is one of the names of s, ignoring case.
+func hasName(s *VirtualServer, name string) bool {
+	for _, n := range s.Names {
+		if strings.EqualFold(n, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ImplicitDefaults returns the sockets whose default server is implicit, because no server
+// listening on them has the default_server parameter.
+func (vh *VirtualHosts) ImplicitDefaults() []*Socket {
+	var sockets []*Socket
+	for _, sock := range vh.Sockets {
+		if sock.ImplicitDefault {
+			sockets = append(sockets, sock)
+		}
+	}
+	return sockets
+}
+
+// Resolve returns the server that handles a request for host, the value of the Host header,
+// received on a connection to the given address and port. Like nginx, it picks the socket
+// listening on that exact address, or else the one listening on any address of the same
+// family, and then chooses among its servers like FindServer, falling back to the default
+// server of the socket. It returns nil if no socket accepts the connection; the address can be
+// an IP address, or a host name or a UNIX-domain socket as written in the listen directives.
+func (vh *VirtualHosts) Resolve(addr string, port int, host string) *VirtualServer {
+	sock := vh.socket(addr, port)
+	if sock == nil {
+		return nil
+	}
+	if s := matchHost(sock.Servers, host); s != nil {
+		return s
+	}
+	return sock.Default
+}
+
+// socket returns the socket that accepts the connections to addr and port.
+func (vh *VirtualHosts) socket(addr string, port int) *Socket {
+	wildcard := "*"
+	if ip, err := netip.ParseAddr(strings.Trim(addr, "[]")); err == nil {
+		addr = formatAddr(ip)
+		if ip.Is6() && !ip.Is4In6() {
+			wildcard = "[::]"
+		}
+	}
+	var found *Socket
+	for _, sock := range vh.Sockets {
+		switch {
+		case sock.Port != port:
+		case sock.Address == addr:
+			return sock
+		case sock.Address == wildcard && found == nil:
+			found = sock
+		}
+	}
+	return found
+}
+
+// NewListen returns the typed view of a listen directive. The address can be an IP address, a
+// host name, "*", a port alone or a UNIX-domain socket, as in "listen unix:/run/nginx.sock";
+// the port defaults to 80. Among the parameters, only those that affect the choice of the
+// server are recorded.
+func NewListen(d *DirectiveNode) (*Listen, error) {
+	_, values := entry(d)
+	if len(values) == 0 {
+		return nil, nodeError(d, "invalid number of arguments in \"listen\" directive")
+	}
+	l := &Listen{Address: "*", Port: 80, Directive: d}
+
+	spec := values[0]
+	host, port := spec, ""
+	switch {
+	case strings.HasPrefix(spec, "unix:"):
+		host = ""
+		l.Address, l.Port = spec, 0
+	case strings.HasPrefix(spec, "["):
+		end := strings.IndexByte(spec, ']')
+		if end < 0 || end+1 < len(spec) && spec[end+1] != ':' {
+			return nil, nodeError(d, "invalid IPv6 address in %q", spec)
+		}
+		host, port = spec[:end+1], strings.TrimPrefix(spec[end+1:], ":")
+	case isNumber(spec):
+		host, port = "*", spec
+	case strings.Contains(spec, ":"):
+		i := strings.LastIndexByte(spec, ':')
+		host, port = spec[:i], spec[i+1:]
+	}
+
+	if host != "" {
+		l.Address = strings.ToLower(host)
+		if ip, err := netip.ParseAddr(strings.Trim(host, "[]")); err == nil {
+			l.Address = formatAddr(ip)
+		}
+		if l.Address == "0.0.0.0" {
+			l.Address = "*"
+		}
+	}
+	if port != "" {
+		n, err := strconv.Atoi(port)
+		if err != nil || n < 1 || n > 65535 {
+			return nil, nodeError(d, "invalid port in %q", spec)
+		}
+		l.Port = n
+	}
+
+	for _, p := range values[1:] {
+		switch p {
+		case "default_server", "default":
+			l.DefaultServer = true
+		case "ssl":
+			l.SSL = true
+		case "http2":
+			l.HTTP2 = true
+		case "proxy_protocol":
+			l.ProxyProtocol = true
+		}
+	}
+	return l, nil
+}
+
+// formatAddr returns an IP address as it's written in a listen directive, with the IPv6
+// addresses in brackets.
+func formatAddr(ip netip.Addr) string {
+	if ip.Is4In6() {
+		ip = ip.Unmap()
+	}
+	if ip.Is6() {
+		return "[" + ip.String() + "]"
+	}
+	return ip.String()
+}
+
+// isNumber reports whether s is a non-empty string of digits.
+func isNumber(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// FindServer returns the server directive of the http blocks in tree that handles the requests
+// for host, which can include a port, regardless of the sockets where the servers listen (see
+// VirtualHosts.Resolve for that). Like nginx, it prefers an exact server name, then the
+// longest name starting with a wildcard, like "*.example.com", then the longest name ending
+// with a wildcard, like "www.example.*", and then the first regular expression, like
+// "~^www\d+\.example\.com$", matching host; if no name matches, the default server is the one
+// with the default_server parameter, or the first one. It returns nil if there are no servers.
+func FindServer(tree *Tree, host string) *DirectiveNode {
+	var servers []*VirtualServer
+	for _, d := range httpServers(tree) {
+		servers = append(servers, &VirtualServer{Directive: d, Names: serverNames(d)})
+	}
+	if len(servers) == 0 {
+		return nil
+	}
+	if s := matchHost(servers, host); s != nil {
+		return s.Directive
+	}
+	for _, s := range servers {
+		if isDefaultServer(s.Directive) {
+			return s.Directive
+		}
+	}
+	return servers[0].Directive
+}
+
+// matchHost returns the server whose names match host best, or nil if no name matches.
+func matchHost(servers []*VirtualServer, host string) *VirtualServer {
+	host = normalizeHost(host)
+	var best *VirtualServer
+	bestKind, bestLen := nameNone, 0
+	for _, s := range servers {
+		for _, name := range s.Names {
+			kind, n := matchServerName(name, host)
+			if kind < bestKind || kind == bestKind && kind != nameRegex && n > bestLen {
+				best, bestKind, bestLen = s, kind, n
+			}
+		}
+	}
+	return best
+}
+
+// normalizeHost removes the port and the trailing dot from a host name, and converts it to
+// lower case.
+func normalizeHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// matchServerName returns the kind of the server name if it matches host, or nameNone, and the
+// length of the part of the name that matches host literally.
+func matchServerName(name, host string) (int, int) {
+	name = strings.ToLower(name)
+	switch {
+	case strings.HasPrefix(name, "~"):
+		re, err := convertPCRE(name[1:])
+		if err != nil {
+			return nameNone, 0
+		}
+		if r, err := regexp.Compile("(?i)" + re); err == nil && r.MatchString(host) {
+			return nameRegex, 0
+		}
+	case strings.HasPrefix(name, "*."):
+		if strings.HasSuffix(host, name[1:]) {
+			return nameLeadingWildcard, len(name) - 1
+		}
+	case strings.HasPrefix(name, "."):
+		// ".example.com" stands for both "example.com" and "*.example.com".
+		if host == name[1:] || strings.HasSuffix(host, name) {
+			return nameLeadingWildcard, len(name)
+		}
+	case strings.HasSuffix(name, ".*"):
+		if strings.HasPrefix(host, name[:len(name)-1]) {
+			return nameTrailingWildcard, len(name) - 1
+		}
+	case name == host:
+		return nameExact, len(name)
+	}
+	return nameNone, 0
+}
+
+// httpServers returns the server directives of the http blocks in tree.
+func httpServers(tree *Tree) []*DirectiveNode {
+	var servers []*DirectiveNode
+	for _, n := range tree.Root.Nodes {
+		d, ok := n.(*DirectiveNode)
+		if !ok || d.Text != "http" {
+			continue
+		}
+		for _, s := range directiveBlock(d) {
+			if s.Text == "server" {
+				servers = append(servers, s)
+			}
+		}
+	}
+	return servers
+}
+
+// serverNames returns the names of a server.
+func serverNames(server *DirectiveNode) []string {
+	var names []string
+	for _, d := range directiveBlock(server) {
+		if d.Text == "server_name" {
+			_, values := entry(d)
+			names = append(names, values...)
+		}
+	}
+	return names
+}
+
+// isDefaultServer reports whether a listen directive of server has the default_server
+// parameter.
+func isDefaultServer(server *DirectiveNode) bool {
+	for _, d := range directiveBlock(server) {
+		if d.Text != "listen" {
+			continue
+		}
+		_, values := entry(d)
+		for _, v := range values {
+			if v == "default_server" || v == "default" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,238 @@
+package nginx
+
+import (
+	"reflect"
+	"testing"
+)
+
+const vhostConfig = `http {
+    server {
+        server_name plain.example.com;
+    }
+    server {
+        listen 10.0.0.1:80;
+        server_name example.com *.example.com;
+    }
+    server {
+        listen 80 default_server;
+        listen [::]:80 default_server;
+        server_name _;
+    }
+    server {
+        listen 443 ssl http2;
+        listen [::]:443 ssl;
+        server_name example.com www.example.*;
+    }
+    server {
+        listen 443 ssl proxy_protocol;
+        server_name ~^api\d+\.example\.com$ EXAMPLE.com;
+    }
+    server {
+        listen 443 default_server ssl;
+        server_name fallback;
+    }
+    server {
+        listen unix:/run/nginx.sock;
+    }
+}
+`
+
+func TestNewListen(t *testing.T) {
+	var tests = []struct {
+		input string
+		want  Listen
+	}{
+		{"listen 8080;", Listen{Address: "*", Port: 8080}},
+		{"listen 127.0.0.1;", Listen{Address: "127.0.0.1", Port: 80}},
+		{"listen 0.0.0.0:81 default;", Listen{Address: "*", Port: 81, DefaultServer: true}},
+		{"listen *:443 ssl http2 proxy_protocol;", Listen{Address: "*", Port: 443, SSL: true, HTTP2: true, ProxyProtocol: true}},
+		{"listen [::]:80 ipv6only=on;", Listen{Address: "[::]", Port: 80}},
+		{"listen [::1];", Listen{Address: "[::1]", Port: 80}},
+		{"listen [::ffff:10.0.0.1]:80;", Listen{Address: "10.0.0.1", Port: 80}},
+		{"listen Localhost:8000 default_server;", Listen{Address: "localhost", Port: 8000, DefaultServer: true}},
+		{"listen unix:/run/nginx.sock;", Listen{Address: "unix:/run/nginx.sock"}},
+	}
+	for _, test := range tests {
+		d := parseHTTPDirective(t, "server { "+test.input+" }")
+		l, err := NewListen(directiveBlock(d)[0])
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", test.input, err)
+			continue
+		}
+		l.Directive = nil
+		if *l != test.want {
+			t.Errorf("%q: got %+v, want %+v", test.input, *l, test.want)
+		}
+	}
+}
+
+func TestNewListenErrors(t *testing.T) {
+	var tests = []struct {
+		input string
+		err   string
+	}{
+		{"listen 70000;", `test:2:10: invalid port in "70000"`},
+		{"listen 127.0.0.1:http;", `test:2:10: invalid port in "127.0.0.1:http"`},
+		{"listen [::1;", `test:2:10: invalid IPv6 address in "[::1"`},
+	}
+	for _, test := range tests {
+		d := parseHTTPDirective(t, "server { "+test.input+" }")
+		_, err := NewListen(directiveBlock(d)[0])
+		if err == nil {
+			t.Errorf("%q: expected an error", test.input)
+			continue
+		}
+		if err.Error() != test.err {
+			t.Errorf("%q: got error %q, want %q", test.input, err, test.err)
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	tree, err := Parse("test", vhostConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vh, err := NewVirtualHosts(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		addr   string
+		port   int
+		host   string
+		server string // the first server name; "" for none.
+	}{
+		{"192.168.1.1", 80, "plain.example.com", "plain.example.com"},
+		{"192.168.1.1", 80, "example.com", "_"},
+		{"192.168.1.1", 80, "", "_"},
+		{"10.0.0.1", 80, "www.example.com", "example.com"},
+		{"10.0.0.1", 80, "plain.example.com", "example.com"},
+		{"::ffff:10.0.0.1", 80, "example.com:80", "example.com"},
+		{"[::1]", 80, "plain.example.com", "_"},
+		{"::1", 443, "www.example.org", "example.com"},
+		{"::1", 443, "api1.example.com", "example.com"},
+		{"192.168.1.1", 443, "example.com", "example.com"},
+		{"192.168.1.1", 443, "www.example.net", "example.com"},
+		{"192.168.1.1", 443, "API12.example.com.", `~^api\d+\.example\.com$`},
+		{"192.168.1.1", 443, "other", "fallback"},
+		{"unix:/run/nginx.sock", 0, "example.com", ""},
+	}
+	for _, test := range tests {
+		s := vh.Resolve(test.addr, test.port, test.host)
+		if s == nil {
+			t.Errorf("%s:%d %q: no server found", test.addr, test.port, test.host)
+			continue
+		}
+		if s.Names[0] != test.server {
+			t.Errorf("%s:%d %q: got server %q, want %q", test.addr, test.port, test.host, s.Names[0], test.server)
+		}
+	}
+
+	if s := vh.Resolve("192.168.1.1", 8080, "example.com"); s != nil {
+		t.Errorf("unexpected server %q on port 8080", s.Names[0])
+	}
+}
+
+func TestVirtualHostsSockets(t *testing.T) {
+	tree, err := Parse("test", vhostConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vh, err := NewVirtualHosts(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var sockets, implicit []string
+	for _, sock := range vh.Sockets {
+		sockets = append(sockets, sock.String())
+	}
+	for _, sock := range vh.ImplicitDefaults() {
+		implicit = append(implicit, sock.String())
+	}
+	wantSockets := []string{"*:80", "10.0.0.1:80", "[::]:80", "*:443", "[::]:443", "unix:/run/nginx.sock"}
+	if !reflect.DeepEqual(sockets, wantSockets) {
+		t.Errorf("got sockets %q, want %q", sockets, wantSockets)
+	}
+	wantImplicit := []string{"10.0.0.1:80", "[::]:443", "unix:/run/nginx.sock"}
+	if !reflect.DeepEqual(implicit, wantImplicit) {
+		t.Errorf("got implicit default servers %q, want %q", implicit, wantImplicit)
+	}
+
+	https := vh.Sockets[3]
+	if !https.SSL || !https.HTTP2 || !https.ProxyProtocol {
+		t.Errorf("%s: got ssl=%v http2=%v proxy_protocol=%v", https, https.SSL, https.HTTP2, https.ProxyProtocol)
+	}
+	if len(https.Servers) != 3 || https.Default.Names[0] != "fallback" {
+		t.Errorf("%s: unexpected servers or default server", https)
+	}
+}
+
+func TestVirtualHostsConflicts(t *testing.T) {
+	const input = `http {
+    server {
+        listen 80 default_server;
+        listen 80;
+        server_name example.com www.example.com;
+    }
+    server {
+        listen 80 default_server;
+        server_name WWW.example.com ~^www ~^www;
+    }
+    server {
+        listen 8080;
+        server_name example.com;
+    }
+}
+`
+	tree, err := Parse("test", input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vh, err := NewVirtualHosts(tree)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, c := range vh.Conflicts {
+		got = append(got, c.String())
+	}
+	want := []string{
+		"test:7:5: a duplicate default server for *:80",
+		`test:7:5: conflicting server name "WWW.example.com" on *:80, ignored`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got conflicts %q, want %q", got, want)
+	}
+	if c := vh.Conflicts[1]; c.First != vh.Servers[0] || c.Second != vh.Servers[1] {
+		t.Errorf("unexpected servers in conflict %q", c)
+	}
+	if s := vh.Resolve("10.0.0.1", 80, "www.example.com"); s != vh.Servers[0] {
+		t.Errorf("the first server should handle the conflicting name")
+	}
+}
+
+func TestFindServer(t *testing.T) {
+	tree, err := Parse("test", vhostConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tests = []struct {
+		host   string
+		server string
+	}{
+		{"example.com", "example.com"},
+		{"www.example.com", "example.com"},
+		{"api3.example.com", "example.com"},
+		{"api3.example.net", "_"},
+	}
+	for _, test := range tests {
+		d := FindServer(tree, test.host)
+		if names := serverNames(d); len(names) == 0 || names[0] != test.server {
+			t.Errorf("%q: got server %q, want %q", test.host, names, test.server)
+		}
+	}
+}